@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	coachTransferProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_transfer"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"os"
+	"strings"
+)
+
+// connect dials the Coach service; the returned context carries the timeout
+// and the bearer token and must be cancelled by the caller
+func (o *options) connect(ctx context.Context) (coachProtobuf.CoachClient, context.Context, func(), error) {
+	conn, ctx, closeConn, err := o.dial(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return coachProtobuf.NewCoachClient(conn), ctx, closeConn, nil
+}
+
+// connectTransfer dials the CoachTransfer service like connect
+func (o *options) connectTransfer(ctx context.Context) (coachTransferProtobuf.CoachTransferClient, context.Context, func(), error) {
+	conn, ctx, closeConn, err := o.dial(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return coachTransferProtobuf.NewCoachTransferClient(conn), ctx, closeConn, nil
+}
+
+func (o *options) dial(ctx context.Context) (*grpc.ClientConn, context.Context, func(), error) {
+	transportCredentials, err := o.transportCredentials()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	conn, err := grpc.NewClient(o.addr, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	if o.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+o.token)
+	}
+
+	closeConn := func() {
+		cancel()
+		_ = conn.Close()
+	}
+
+	return conn, ctx, closeConn, nil
+}
+
+func (o *options) transportCredentials() (credentials.TransportCredentials, error) {
+	if !o.tls && o.caFile == "" && o.certFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: o.serverName,
+	}
+
+	if o.caFile != "" {
+		caPEM, err := os.ReadFile(o.caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in %s", o.caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if o.certFile != "" || o.keyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(o.certFile, o.keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// describeError renders a gRPC status with its field violations, if any
+func describeError(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err.Error()
+	}
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "%s: %s", st.Code(), st.Message())
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fmt.Fprintf(&message, "\n  %s: %s", violation.Field, violation.Description)
+			}
+		}
+	}
+
+	return message.String()
+}
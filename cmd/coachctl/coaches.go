@@ -0,0 +1,350 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"os"
+	"strings"
+)
+
+// locationMetadataKey narrows coach listings to one location
+const locationMetadataKey = "x-location-id"
+
+// unavailableSectionsKey is the trailer naming the sections a listing left out
+const unavailableSectionsKey = "x-unavailable-sections"
+
+// servicesSection is the unavailable section of coach services
+const servicesSection = "services"
+
+// photoChunkSize keeps every stream message far below the 4MB gRPC limit
+const photoChunkSize = 64 << 10
+
+func newListCommand(opts *options) *cobra.Command {
+	var locationId string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List coaches",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, ctx, closeConn, err := opts.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			if locationId != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, locationMetadataKey, locationId)
+			}
+
+			response, err := client.GetCoaches(ctx, &emptypb.Empty{})
+			if err != nil {
+				return err
+			}
+
+			rows := make([]coachRow, 0, len(response.CoachObjects))
+			for _, coach := range response.CoachObjects {
+				rows = append(rows, coachRow{coach: coach})
+			}
+
+			return printResponse(cmd.OutOrStdout(), opts.output, response, rows)
+		},
+	}
+
+	cmd.Flags().StringVar(&locationId, "location", "", "only list the coaches of this location id")
+
+	return cmd
+}
+
+func newShowCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:               "show <id>",
+		Short:             "Show a coach",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeCoachIds(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, ctx, closeConn, err := opts.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			response, err := client.GetCoachById(ctx, &coachProtobuf.GetCoachByIdRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return printResponse(cmd.OutOrStdout(), opts.output, response, []coachRow{{coach: response.CoachObject}})
+		},
+	}
+}
+
+func newCreateCommand(opts *options) *cobra.Command {
+	var data coachProtobuf.CoachDataForCreate
+	var photoFile string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a coach, optionally uploading a photo",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			photo, err := readPhoto(photoFile)
+			if err != nil {
+				return err
+			}
+
+			client, ctx, closeConn, err := opts.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			stream, err := client.CreateCoach(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = sendCoach(stream,
+				&coachProtobuf.CreateCoachRequest{Payload: &coachProtobuf.CreateCoachRequest_CoachDataForCreate{CoachDataForCreate: &data}},
+				photo,
+				func(chunk []byte) *coachProtobuf.CreateCoachRequest {
+					return &coachProtobuf.CreateCoachRequest{Payload: &coachProtobuf.CreateCoachRequest_CoachPhoto{CoachPhoto: chunk}}
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			response, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+
+			return printResponse(cmd.OutOrStdout(), opts.output, response, []coachRow{{
+				coach:    response.CoachWithServices.GetCoach(),
+				services: response.CoachWithServices.GetServices(),
+			}})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&data.Name, "name", "", "coach name")
+	flags.StringVar(&data.Description, "description", "", "coach description")
+	flags.StringSliceVar(&data.CoachServiceIds, "service-id", nil, "id of a service the coach runs, repeatable")
+	flags.StringVar(&photoFile, "photo", "", "photo file to upload")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagFilename("photo", "jpg", "jpeg", "png", "webp")
+
+	return cmd
+}
+
+func newUpdateCommand(opts *options) *cobra.Command {
+	var data coachProtobuf.CoachDataForUpdate
+	var photoFile string
+
+	cmd := &cobra.Command{
+		Use:               "update <id>",
+		Short:             "Update a coach; unset flags leave fields unchanged",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeCoachIds(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data.Id = args[0]
+
+			photo, err := readPhoto(photoFile)
+			if err != nil {
+				return err
+			}
+
+			client, ctx, closeConn, err := opts.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			//the server replaces the services with whatever is sent, so resend the current ones
+			if !cmd.Flags().Changed("service-id") {
+				data.CoachServiceIds, err = currentServiceIds(ctx, client, data.Id)
+				if err != nil {
+					return err
+				}
+			}
+
+			stream, err := client.UpdateCoach(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = sendCoach(stream,
+				&coachProtobuf.UpdateCoachRequest{Payload: &coachProtobuf.UpdateCoachRequest_CoachDataForUpdate{CoachDataForUpdate: &data}},
+				photo,
+				func(chunk []byte) *coachProtobuf.UpdateCoachRequest {
+					return &coachProtobuf.UpdateCoachRequest{Payload: &coachProtobuf.UpdateCoachRequest_CoachPhoto{CoachPhoto: chunk}}
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			response, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+
+			return printResponse(cmd.OutOrStdout(), opts.output, response, []coachRow{{
+				coach:    response.CoachWithServices.GetCoach(),
+				services: response.CoachWithServices.GetServices(),
+			}})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&data.Name, "name", "", "new coach name")
+	flags.StringVar(&data.Description, "description", "", "new coach description")
+	flags.StringSliceVar(&data.CoachServiceIds, "service-id", nil, "id of a service the coach runs, repeatable; replaces the current services, which are kept when unset")
+	flags.StringVar(&photoFile, "photo", "", "new photo file to upload")
+	_ = cmd.MarkFlagFilename("photo", "jpg", "jpeg", "png", "webp")
+
+	return cmd
+}
+
+func newDeleteCommand(opts *options) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:               "delete <id>",
+		Short:             "Delete a coach and its photo",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeCoachIds(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !yes && !confirm(cmd, fmt.Sprintf("Delete coach %s?", args[0])) {
+				return errors.New("aborted")
+			}
+
+			client, ctx, closeConn, err := opts.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			response, err := client.DeleteCoachById(ctx, &coachProtobuf.DeleteCoachByIdRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return printResponse(cmd.OutOrStdout(), opts.output, response, []coachRow{{coach: response.CoachObject}})
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "don't ask for confirmation")
+
+	return cmd
+}
+
+// currentServiceIds reads the ids of the services a coach runs now, failing
+// rather than guessing when the listing can't tell
+func currentServiceIds(ctx context.Context, client coachProtobuf.CoachClient, coachId string) ([]string, error) {
+	var trailer metadata.MD
+	response, err := client.GetCoachesWithServicesWithReviewsWithUsers(ctx, &emptypb.Empty{}, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, fmt.Errorf("read the current services of coach %s: %w", coachId, err)
+	}
+
+	for _, section := range trailer.Get(unavailableSectionsKey) {
+		if section == servicesSection {
+			return nil, fmt.Errorf("read the current services of coach %s: the service service is unavailable; pass --service-id to set them", coachId)
+		}
+	}
+
+	for _, coach := range response.CoachWithServicesWithReviewsWithUsers {
+		if coach.GetCoach().GetId() != coachId {
+			continue
+		}
+
+		ids := make([]string, 0, len(coach.Services))
+		for _, service := range coach.Services {
+			ids = append(ids, service.GetId())
+		}
+		return ids, nil
+	}
+
+	return nil, fmt.Errorf("read the current services of coach %s: the coach isn't listed publicly; pass --service-id to set them", coachId)
+}
+
+// sendCoach sends the coach data followed by the photo in chunks
+func sendCoach[Req any, Res any](
+	stream grpc.ClientStreamingClient[Req, Res],
+	data *Req,
+	photo []byte,
+	photoChunk func(chunk []byte) *Req,
+) error {
+	if err := stream.Send(data); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(photo); start += photoChunkSize {
+		end := min(start+photoChunkSize, len(photo))
+		if err := stream.Send(photoChunk(photo[start:end])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readPhoto(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	return os.ReadFile(path)
+}
+
+func confirm(cmd *cobra.Command, question string) bool {
+	fmt.Fprintf(cmd.ErrOrStderr(), "%s [y/N] ", question)
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes"
+}
+
+// completeCoachIds completes coach ids, described by the coach name
+func completeCoachIds(opts *options) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		client, ctx, closeConn, err := opts.connect(cmd.Context())
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		defer closeConn()
+
+		response, err := client.GetCoaches(ctx, &emptypb.Empty{})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var completions []string
+		for _, coach := range response.CoachObjects {
+			if strings.HasPrefix(coach.Id, toComplete) {
+				completions = append(completions, coach.Id+"\t"+coach.Name)
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,14 @@
+// coachctl manages coaches through the Coach gRPC API
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", describeError(err))
+		os.Exit(1)
+	}
+}
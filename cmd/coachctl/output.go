@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	serviceProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+)
+
+var outputFormats = []string{"table", "json", "yaml"}
+
+// maxDescriptionWidth truncates descriptions in tables
+const maxDescriptionWidth = 40
+
+// coachRow is a table line; services are nil when the call doesn't return them
+type coachRow struct {
+	coach    *coachProtobuf.CoachObject
+	services []*serviceProtobuf.ServiceObject
+}
+
+// printResponse writes the whole response as JSON or YAML, or its rows as a table
+func printResponse(w io.Writer, format string, response proto.Message, rows []coachRow) error {
+	switch format {
+	case "json":
+		content, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(response)
+		if err != nil {
+			return err
+		}
+
+		//protojson randomizes its whitespace on purpose, so indent it ourselves
+		var indented bytes.Buffer
+		if err = json.Indent(&indented, content, "", "  "); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(w, indented.String())
+		return err
+	case "yaml":
+		return printYAML(w, response)
+	case "table":
+		return printTable(w, rows)
+	default:
+		return fmt.Errorf("unknown output format %q, want one of %s", format, strings.Join(outputFormats, ", "))
+	}
+}
+
+// printYAML goes through the protobuf JSON mapping, which YAML is a superset
+// of, so field names and order match the json output
+func printYAML(w io.Writer, response proto.Message) error {
+	content, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	var document yaml.Node
+	if err = yaml.Unmarshal(content, &document); err != nil {
+		return err
+	}
+	blockStyle(&document)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&document); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// blockStyle drops the flow style JSON input is parsed with
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+func printTable(w io.Writer, rows []coachRow) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "ID\tNAME\tDESCRIPTION\tSERVICES\tUPDATED")
+	for _, row := range rows {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n",
+			row.coach.Id,
+			row.coach.Name,
+			truncate(row.coach.Description, maxDescriptionWidth),
+			serviceTitles(row.services),
+			row.coach.UpdatedTime,
+		)
+	}
+
+	return table.Flush()
+}
+
+func serviceTitles(services []*serviceProtobuf.ServiceObject) string {
+	if services == nil {
+		return "-"
+	}
+
+	titles := make([]string, 0, len(services))
+	for _, service := range services {
+		titles = append(titles, service.Title)
+	}
+
+	return strings.Join(titles, ", ")
+}
+
+func truncate(value string, width int) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if utf8.RuneCountInString(value) <= width {
+		return value
+	}
+
+	return string([]rune(value)[:width-1]) + "…"
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"os"
+	"time"
+)
+
+// options are the flags shared by every command
+type options struct {
+	addr       string
+	token      string
+	output     string
+	timeout    time.Duration
+	tls        bool
+	caFile     string
+	certFile   string
+	keyFile    string
+	serverName string
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &options{}
+
+	root := &cobra.Command{
+		Use:           "coachctl",
+		Short:         "Manage coaches through the Coach gRPC API",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.addr, "addr", envOr("COACHCTL_ADDR", "localhost:5000"), "Coach server address ($COACHCTL_ADDR)")
+	flags.StringVar(&opts.token, "token", os.Getenv("COACHCTL_TOKEN"), "bearer token ($COACHCTL_TOKEN)")
+	flags.StringVarP(&opts.output, "output", "o", "table", "output format: table, json or yaml")
+	flags.DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout of each call")
+	flags.BoolVar(&opts.tls, "tls", false, "connect over TLS verified against the system roots")
+	flags.StringVar(&opts.caFile, "ca-file", "", "CA verifying the server, implies --tls")
+	flags.StringVar(&opts.certFile, "cert-file", "", "client certificate for mTLS")
+	flags.StringVar(&opts.keyFile, "key-file", "", "client key for mTLS")
+	flags.StringVar(&opts.serverName, "server-name", "", "expected server name, defaults to the host of --addr")
+
+	_ = root.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(outputFormats, cobra.ShellCompDirectiveNoFileComp))
+
+	root.AddCommand(
+		newListCommand(opts),
+		newShowCommand(opts),
+		newCreateCommand(opts),
+		newUpdateCommand(opts),
+		newDeleteCommand(opts),
+		newImportCommand(opts),
+		newExportCommand(opts),
+	)
+
+	return root
+}
+
+func envOr(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+
+	return fallback
+}
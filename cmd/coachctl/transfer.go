@@ -0,0 +1,488 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	coachTransferProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_transfer"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+var transferFormats = []string{"csv", "jsonl"}
+
+// csvColumns are written by export; import requires name and ignores id
+var csvColumns = []string{"id", "external_id", "name", "description", "service_ids", "photo"}
+
+// fileRecord is a coach as stored in import and export files. Photo is an
+// http(s) URL the server downloads or a path, relative to the file, of a
+// photo to upload.
+type fileRecord struct {
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"external_id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	ServiceIds  []string `json:"service_ids,omitempty"`
+	Photo       string   `json:"photo,omitempty"`
+}
+
+// importRow is a record read from an import file with the line it starts on
+// and the local photo to upload after it, if any
+type importRow struct {
+	line      int
+	record    *coachTransferProtobuf.CoachRecord
+	photoPath string
+}
+
+func newImportCommand(opts *options) *cobra.Command {
+	var format string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Create or update coaches from a CSV or JSON Lines file",
+		Long: `Create or update coaches from a CSV or JSON Lines file, "-" reading stdin.
+
+A record whose external_id matches a coach updates it, any other record creates
+a coach; empty fields of an updated coach keep their value. CSV files need a
+header naming the columns external_id, name, description, service_ids
+(separated by ";") and photo, an id column is ignored. JSON Lines files hold
+one object per line with the same keys, service_ids being an array.
+
+A photo is either an http(s) URL the server downloads or the path, relative
+to the file, of a photo to upload. Large imports may need a longer --timeout.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rows, err := readImportFile(args[0], format)
+			if err != nil {
+				return err
+			}
+
+			client, ctx, closeConn, err := opts.connectTransfer(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			stream, err := client.ImportCoaches(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = stream.Send(&coachTransferProtobuf.ImportCoachesRequest{
+				Payload: &coachTransferProtobuf.ImportCoachesRequest_Options{
+					Options: &coachTransferProtobuf.ImportOptions{DryRun: dryRun},
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, row := range rows {
+				//photos are read one at a time to keep large imports out of memory
+				photo, err := readPhoto(row.photoPath)
+				if err != nil {
+					return fmt.Errorf("line %d: %w", row.line, err)
+				}
+
+				err = sendCoach(stream,
+					&coachTransferProtobuf.ImportCoachesRequest{Payload: &coachTransferProtobuf.ImportCoachesRequest_Record{Record: row.record}},
+					photo,
+					func(chunk []byte) *coachTransferProtobuf.ImportCoachesRequest {
+						return &coachTransferProtobuf.ImportCoachesRequest{Payload: &coachTransferProtobuf.ImportCoachesRequest_PhotoChunk{PhotoChunk: chunk}}
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			response, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+
+			if opts.output == "table" {
+				err = printImportTable(cmd.OutOrStdout(), response, rows)
+			} else {
+				err = printResponse(cmd.OutOrStdout(), opts.output, response, nil)
+			}
+			if err != nil {
+				return err
+			}
+
+			if response.Failed > 0 {
+				return fmt.Errorf("%d of %d records failed", response.Failed, len(rows))
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "file format: csv or jsonl, guessed from the file extension by default")
+	flags.BoolVar(&dryRun, "dry-run", false, "validate the records and report what would change without writing")
+	_ = cmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(transferFormats, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newExportCommand(opts *options) *cobra.Command {
+	var format string
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write every coach to a CSV or JSON Lines file that import accepts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format == "" {
+				format = formatOf(file, "csv")
+			}
+			if !slices.Contains(transferFormats, format) {
+				return fmt.Errorf("unknown format %q, want one of %s", format, strings.Join(transferFormats, ", "))
+			}
+
+			client, ctx, closeConn, err := opts.connectTransfer(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			stream, err := client.ExportCoaches(ctx, &coachTransferProtobuf.ExportCoachesRequest{})
+			if err != nil {
+				return err
+			}
+
+			var records []fileRecord
+			for {
+				response, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					return err
+				}
+
+				record := response.Record
+				records = append(records, fileRecord{
+					Id:          record.Id,
+					ExternalId:  record.ExternalId,
+					Name:        record.Name,
+					Description: record.Description,
+					ServiceIds:  record.CoachServiceIds,
+					Photo:       record.PhotoUrl,
+				})
+			}
+
+			write := writeCSV
+			if format == "jsonl" {
+				write = writeJSONLines
+			}
+
+			if file == "" || file == "-" {
+				return write(cmd.OutOrStdout(), records)
+			}
+
+			//the file is only created once the whole export has been received
+			created, err := os.Create(file)
+			if err != nil {
+				return err
+			}
+
+			if err = write(created, records); err != nil {
+				_ = created.Close()
+				return err
+			}
+
+			return created.Close()
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&format, "format", "", "file format: csv or jsonl, guessed from --file or csv by default")
+	flags.StringVar(&file, "file", "", "file to write, stdout by default")
+	_ = cmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(transferFormats, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.MarkFlagFilename("file", "csv", "jsonl")
+
+	return cmd
+}
+
+// formatOf guesses the format of path from its extension
+func formatOf(path, fallback string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	default:
+		return fallback
+	}
+}
+
+// readImportFile reads every record and checks their local photos up front,
+// so a broken file is reported before anything is sent
+func readImportFile(path, format string) ([]importRow, error) {
+	if format == "" {
+		format = formatOf(path, "")
+		if format == "" {
+			return nil, fmt.Errorf("can't tell the format of %s, set --format", path)
+		}
+	}
+
+	var in io.Reader = os.Stdin
+	baseDir := "."
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		in = file
+		baseDir = filepath.Dir(path)
+	}
+
+	var rows []importRow
+	var err error
+	switch format {
+	case "csv":
+		rows, err = readCSV(in, baseDir)
+	case "jsonl":
+		rows, err = readJSONLines(in, baseDir)
+	default:
+		return nil, fmt.Errorf("unknown format %q, want one of %s", format, strings.Join(transferFormats, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%s holds no records", path)
+	}
+
+	return rows, nil
+}
+
+func readCSV(in io.Reader, baseDir string) ([]importRow, error) {
+	reader := csv.NewReader(in)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(csvColumns, column) {
+			return nil, fmt.Errorf("unknown CSV column %q, want some of %s", column, strings.Join(csvColumns, ", "))
+		}
+
+		columns[column] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New("CSV header has no name column")
+	}
+
+	var rows []importRow
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		field := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(fields[i])
+			}
+
+			return ""
+		}
+
+		var serviceIds []string
+		for _, serviceId := range strings.Split(field("service_ids"), ";") {
+			if serviceId = strings.TrimSpace(serviceId); serviceId != "" {
+				serviceIds = append(serviceIds, serviceId)
+			}
+		}
+
+		row, err := newImportRow(line, fileRecord{
+			ExternalId:  field("external_id"),
+			Name:        field("name"),
+			Description: field("description"),
+			ServiceIds:  serviceIds,
+			Photo:       field("photo"),
+		}, baseDir)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func readJSONLines(in io.Reader, baseDir string) ([]importRow, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 1<<20)
+
+	var rows []importRow
+	for line := 1; scanner.Scan(); line++ {
+		content := strings.TrimSpace(scanner.Text())
+		if content == "" {
+			continue
+		}
+
+		var record fileRecord
+		decoder := json.NewDecoder(strings.NewReader(content))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row, err := newImportRow(line, record, baseDir)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, scanner.Err()
+}
+
+func newImportRow(line int, record fileRecord, baseDir string) (importRow, error) {
+	row := importRow{
+		line: line,
+		record: &coachTransferProtobuf.CoachRecord{
+			ExternalId:      record.ExternalId,
+			Name:            record.Name,
+			Description:     record.Description,
+			CoachServiceIds: record.ServiceIds,
+		},
+	}
+
+	switch {
+	case record.Photo == "":
+	case strings.HasPrefix(record.Photo, "http://") || strings.HasPrefix(record.Photo, "https://"):
+		row.record.PhotoUrl = record.Photo
+	default:
+		photoPath := record.Photo
+		if !filepath.IsAbs(photoPath) {
+			photoPath = filepath.Join(baseDir, photoPath)
+		}
+
+		info, err := os.Stat(photoPath)
+		if err != nil {
+			return importRow{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		if !info.Mode().IsRegular() {
+			return importRow{}, fmt.Errorf("line %d: photo %s is not a file", line, photoPath)
+		}
+		row.photoPath = photoPath
+	}
+
+	return row, nil
+}
+
+func writeCSV(w io.Writer, records []fileRecord) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvColumns); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		err := writer.Write([]string{
+			record.Id,
+			record.ExternalId,
+			record.Name,
+			record.Description,
+			strings.Join(record.ServiceIds, ";"),
+			record.Photo,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func writeJSONLines(w io.Writer, records []fileRecord) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printImportTable prints a line per record, located by its line in the file
+func printImportTable(w io.Writer, response *coachTransferProtobuf.ImportCoachesResponse, rows []importRow) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "LINE\tEXTERNAL ID\tACTION\tCOACH ID\tERROR")
+	for _, result := range response.Results {
+		line := "-"
+		if index := int(result.Row) - 1; index >= 0 && index < len(rows) {
+			line = fmt.Sprint(rows[index].line)
+		}
+
+		problem := result.Error
+		if len(result.FieldViolations) > 0 {
+			violations := make([]string, 0, len(result.FieldViolations))
+			for _, violation := range result.FieldViolations {
+				violations = append(violations, violation.Field+": "+violation.Description)
+			}
+			problem = strings.Join(violations, "; ")
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n",
+			line,
+			orDash(result.ExternalId),
+			strings.ToLower(result.Action.String()),
+			orDash(result.CoachId),
+			orDash(problem),
+		)
+	}
+
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("%d created, %d updated, %d failed", response.Created, response.Updated, response.Failed)
+	if response.DryRun {
+		summary += " (dry run, nothing was written)"
+	}
+
+	_, err := fmt.Fprintln(w, summary)
+	return err
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
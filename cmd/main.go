@@ -1,36 +1,119 @@
 package main
 
 import (
-	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/config"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/migrations"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/server"
 	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
-	"github.com/joho/godotenv"
+	"github.com/jmoiron/sqlx"
 	"os"
+	"strconv"
 )
 
+const migrateUsage = "usage: coach migrate up | down [steps] | status [config flags]"
+
 func main() {
-	err := godotenv.Load()
+	ctx := context.Background()
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate(ctx, os.Args[2:]); err != nil {
+			logger.Fatal(ctx, "Migration failed", "error", err)
+		}
+
+		return
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		logger.Fatal(ctx, "Error loading configuration", "error", err)
+	}
+
+	logger.Info(ctx, "Successfully loaded configuration")
+
+	if err = logger.SetLevel(cfg.App.LogLevel); err != nil {
+		logger.Fatal(ctx, "Invalid log level", "error", err)
+	}
+
+	appGRPC, err := server.NewAppGRPC(cfg)
+	if err != nil {
+		logger.Fatal(ctx, "Error initializing app", "error", err)
+	}
+
+	err = appGRPC.Run(cfg.App.Port)
 	if err != nil {
-		logger.FatalLogger.Fatalf("Error loading .env file: %s", err)
+		logger.Fatal(ctx, "Error running server", "error", err)
+	}
+}
+
+// migrate runs "coach migrate <action>", where action is up, down with an
+// optional number of steps (1 by default) or status
+func migrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
 	}
 
-	logger.InfoLogger.Printf("Successfully loaded environment variables")
+	action, args := args[0], args[1:]
 
-	cloudConfig := &models.CloudConfig{
-		EndPoint: os.Getenv("AWS_ENDPOINT"),
-		Region:   os.Getenv("AWS_REGION"),
-		Bucket:   os.Getenv("AWS_S3_BUCKET"),
-		Key:      os.Getenv("AWS_KEY"),
-		Secret:   os.Getenv("AWS_SECRET"),
+	steps := 1
+	if action == "down" && len(args) > 0 {
+		if parsedSteps, err := strconv.Atoi(args[0]); err == nil {
+			if parsedSteps < 1 {
+				return errors.New("steps must be at least 1")
+			}
+
+			steps, args = parsedSteps, args[1:]
+		}
 	}
 
-	appGRPC, err := server.NewAppGRPC(cloudConfig)
+	cfg, err := config.LoadDatabase(args)
 	if err != nil {
-		logger.FatalLogger.Fatalf("Error initializing app: %s", err)
+		return err
 	}
 
-	err = appGRPC.Run(os.Getenv("APP_PORT"))
+	db, err := sqlx.Connect(cfg.DB.Driver, cfg.DB.DSN())
 	if err != nil {
-		logger.FatalLogger.Fatalf("Error running server")
+		return err
 	}
+	defer db.Close()
+
+	migrator, err := migrations.New(db)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Info(ctx, "Migrations applied", "applied", applied)
+	case "down":
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		logger.Info(ctx, "Migrations reverted", "reverted", reverted)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Migration.Version, status.Migration.Name, appliedAt)
+		}
+	default:
+		return fmt.Errorf("unknown migrate action %q, %s", action, migrateUsage)
+	}
+
+	return nil
 }
@@ -0,0 +1,550 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: coach_contact.proto
+
+package FitnessCenter_protobuf_coach_contact
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Email is an address, PHONE, WHATSAPP and VIBER are E.164 numbers like
+// "+375291234567", TELEGRAM is a username and the rest are https URLs,
+// those of social networks on their own site
+type ContactKind int32
+
+const (
+	ContactKind_CONTACT_KIND_UNSPECIFIED ContactKind = 0
+	ContactKind_EMAIL                    ContactKind = 1
+	ContactKind_PHONE                    ContactKind = 2
+	ContactKind_TELEGRAM                 ContactKind = 3
+	ContactKind_WHATSAPP                 ContactKind = 4
+	ContactKind_VIBER                    ContactKind = 5
+	ContactKind_INSTAGRAM                ContactKind = 6
+	ContactKind_FACEBOOK                 ContactKind = 7
+	ContactKind_VK                       ContactKind = 8
+	ContactKind_YOUTUBE                  ContactKind = 9
+	ContactKind_WEBSITE                  ContactKind = 10
+)
+
+// Enum value maps for ContactKind.
+var (
+	ContactKind_name = map[int32]string{
+		0:  "CONTACT_KIND_UNSPECIFIED",
+		1:  "EMAIL",
+		2:  "PHONE",
+		3:  "TELEGRAM",
+		4:  "WHATSAPP",
+		5:  "VIBER",
+		6:  "INSTAGRAM",
+		7:  "FACEBOOK",
+		8:  "VK",
+		9:  "YOUTUBE",
+		10: "WEBSITE",
+	}
+	ContactKind_value = map[string]int32{
+		"CONTACT_KIND_UNSPECIFIED": 0,
+		"EMAIL":                    1,
+		"PHONE":                    2,
+		"TELEGRAM":                 3,
+		"WHATSAPP":                 4,
+		"VIBER":                    5,
+		"INSTAGRAM":                6,
+		"FACEBOOK":                 7,
+		"VK":                       8,
+		"YOUTUBE":                  9,
+		"WEBSITE":                  10,
+	}
+)
+
+func (x ContactKind) Enum() *ContactKind {
+	p := new(ContactKind)
+	*p = x
+	return p
+}
+
+func (x ContactKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_coach_contact_proto_enumTypes[0].Descriptor()
+}
+
+func (ContactKind) Type() protoreflect.EnumType {
+	return &file_coach_contact_proto_enumTypes[0]
+}
+
+func (x ContactKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactKind.Descriptor instead.
+func (ContactKind) EnumDescriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{0}
+}
+
+type ContactVisibility int32
+
+const (
+	ContactVisibility_CONTACT_VISIBILITY_UNSPECIFIED ContactVisibility = 0
+	ContactVisibility_PUBLIC                         ContactVisibility = 1
+	ContactVisibility_MEMBERS                        ContactVisibility = 2
+	ContactVisibility_STAFF                          ContactVisibility = 3
+)
+
+// Enum value maps for ContactVisibility.
+var (
+	ContactVisibility_name = map[int32]string{
+		0: "CONTACT_VISIBILITY_UNSPECIFIED",
+		1: "PUBLIC",
+		2: "MEMBERS",
+		3: "STAFF",
+	}
+	ContactVisibility_value = map[string]int32{
+		"CONTACT_VISIBILITY_UNSPECIFIED": 0,
+		"PUBLIC":                         1,
+		"MEMBERS":                        2,
+		"STAFF":                          3,
+	}
+)
+
+func (x ContactVisibility) Enum() *ContactVisibility {
+	p := new(ContactVisibility)
+	*p = x
+	return p
+}
+
+func (x ContactVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_coach_contact_proto_enumTypes[1].Descriptor()
+}
+
+func (ContactVisibility) Type() protoreflect.EnumType {
+	return &file_coach_contact_proto_enumTypes[1]
+}
+
+func (x ContactVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactVisibility.Descriptor instead.
+func (ContactVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{1}
+}
+
+type CoachContactObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       ContactKind       `protobuf:"varint,1,opt,name=kind,proto3,enum=fitness_center.coach_contact.ContactKind" json:"kind,omitempty"`
+	Value      string            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Visibility ContactVisibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=fitness_center.coach_contact.ContactVisibility" json:"visibility,omitempty"`
+}
+
+func (x *CoachContactObject) Reset() {
+	*x = CoachContactObject{}
+	mi := &file_coach_contact_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoachContactObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachContactObject) ProtoMessage() {}
+
+func (x *CoachContactObject) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_contact_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachContactObject.ProtoReflect.Descriptor instead.
+func (*CoachContactObject) Descriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CoachContactObject) GetKind() ContactKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ContactKind_CONTACT_KIND_UNSPECIFIED
+}
+
+func (x *CoachContactObject) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CoachContactObject) GetVisibility() ContactVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return ContactVisibility_CONTACT_VISIBILITY_UNSPECIFIED
+}
+
+// A coach has at most one contact of every kind
+type SetCoachContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId  string                `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	Contacts []*CoachContactObject `protobuf:"bytes,2,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *SetCoachContactsRequest) Reset() {
+	*x = SetCoachContactsRequest{}
+	mi := &file_coach_contact_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoachContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoachContactsRequest) ProtoMessage() {}
+
+func (x *SetCoachContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_contact_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoachContactsRequest.ProtoReflect.Descriptor instead.
+func (*SetCoachContactsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetCoachContactsRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *SetCoachContactsRequest) GetContacts() []*CoachContactObject {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type SetCoachContactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts []*CoachContactObject `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *SetCoachContactsResponse) Reset() {
+	*x = SetCoachContactsResponse{}
+	mi := &file_coach_contact_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoachContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoachContactsResponse) ProtoMessage() {}
+
+func (x *SetCoachContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_contact_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoachContactsResponse.ProtoReflect.Descriptor instead.
+func (*SetCoachContactsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetCoachContactsResponse) GetContacts() []*CoachContactObject {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type GetCoachContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *GetCoachContactsRequest) Reset() {
+	*x = GetCoachContactsRequest{}
+	mi := &file_coach_contact_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachContactsRequest) ProtoMessage() {}
+
+func (x *GetCoachContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_contact_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachContactsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachContactsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCoachContactsRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type GetCoachContactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts []*CoachContactObject `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *GetCoachContactsResponse) Reset() {
+	*x = GetCoachContactsResponse{}
+	mi := &file_coach_contact_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachContactsResponse) ProtoMessage() {}
+
+func (x *GetCoachContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_contact_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachContactsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachContactsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_contact_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCoachContactsResponse) GetContacts() []*CoachContactObject {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+var File_coach_contact_proto protoreflect.FileDescriptor
+
+var file_coach_contact_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x4f, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x22, 0x82, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22,
+	0x34, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x2a,
+	0xa7, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x48, 0x4f, 0x4e,
+	0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x45, 0x4c, 0x45, 0x47, 0x52, 0x41, 0x4d, 0x10,
+	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x48, 0x41, 0x54, 0x53, 0x41, 0x50, 0x50, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x56, 0x49, 0x42, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e,
+	0x53, 0x54, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x43,
+	0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x07, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x4b, 0x10, 0x08, 0x12,
+	0x0b, 0x0a, 0x07, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07,
+	0x57, 0x45, 0x42, 0x53, 0x49, 0x54, 0x45, 0x10, 0x0a, 0x2a, 0x5b, 0x0a, 0x11, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22,
+	0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x53,
+	0x54, 0x41, 0x46, 0x46, 0x10, 0x03, 0x32, 0x96, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x35, 0x2e, 0x66,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
+	0x12, 0x35, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x54, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61,
+	0x6e, 0x4b, 0x6f, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_contact_proto_rawDescOnce sync.Once
+	file_coach_contact_proto_rawDescData = file_coach_contact_proto_rawDesc
+)
+
+func file_coach_contact_proto_rawDescGZIP() []byte {
+	file_coach_contact_proto_rawDescOnce.Do(func() {
+		file_coach_contact_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_contact_proto_rawDescData)
+	})
+	return file_coach_contact_proto_rawDescData
+}
+
+var file_coach_contact_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_coach_contact_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_coach_contact_proto_goTypes = []any{
+	(ContactKind)(0),                 // 0: fitness_center.coach_contact.ContactKind
+	(ContactVisibility)(0),           // 1: fitness_center.coach_contact.ContactVisibility
+	(*CoachContactObject)(nil),       // 2: fitness_center.coach_contact.CoachContactObject
+	(*SetCoachContactsRequest)(nil),  // 3: fitness_center.coach_contact.SetCoachContactsRequest
+	(*SetCoachContactsResponse)(nil), // 4: fitness_center.coach_contact.SetCoachContactsResponse
+	(*GetCoachContactsRequest)(nil),  // 5: fitness_center.coach_contact.GetCoachContactsRequest
+	(*GetCoachContactsResponse)(nil), // 6: fitness_center.coach_contact.GetCoachContactsResponse
+}
+var file_coach_contact_proto_depIdxs = []int32{
+	0, // 0: fitness_center.coach_contact.CoachContactObject.kind:type_name -> fitness_center.coach_contact.ContactKind
+	1, // 1: fitness_center.coach_contact.CoachContactObject.visibility:type_name -> fitness_center.coach_contact.ContactVisibility
+	2, // 2: fitness_center.coach_contact.SetCoachContactsRequest.contacts:type_name -> fitness_center.coach_contact.CoachContactObject
+	2, // 3: fitness_center.coach_contact.SetCoachContactsResponse.contacts:type_name -> fitness_center.coach_contact.CoachContactObject
+	2, // 4: fitness_center.coach_contact.GetCoachContactsResponse.contacts:type_name -> fitness_center.coach_contact.CoachContactObject
+	3, // 5: fitness_center.coach_contact.CoachContact.SetCoachContacts:input_type -> fitness_center.coach_contact.SetCoachContactsRequest
+	5, // 6: fitness_center.coach_contact.CoachContact.GetCoachContacts:input_type -> fitness_center.coach_contact.GetCoachContactsRequest
+	4, // 7: fitness_center.coach_contact.CoachContact.SetCoachContacts:output_type -> fitness_center.coach_contact.SetCoachContactsResponse
+	6, // 8: fitness_center.coach_contact.CoachContact.GetCoachContacts:output_type -> fitness_center.coach_contact.GetCoachContactsResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_coach_contact_proto_init() }
+func file_coach_contact_proto_init() {
+	if File_coach_contact_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_contact_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_contact_proto_goTypes,
+		DependencyIndexes: file_coach_contact_proto_depIdxs,
+		EnumInfos:         file_coach_contact_proto_enumTypes,
+		MessageInfos:      file_coach_contact_proto_msgTypes,
+	}.Build()
+	File_coach_contact_proto = out.File
+	file_coach_contact_proto_rawDesc = nil
+	file_coach_contact_proto_goTypes = nil
+	file_coach_contact_proto_depIdxs = nil
+}
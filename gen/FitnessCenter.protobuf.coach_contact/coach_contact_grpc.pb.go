@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_contact.proto
+
+package FitnessCenter_protobuf_coach_contact
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachContact_SetCoachContacts_FullMethodName = "/fitness_center.coach_contact.CoachContact/SetCoachContacts"
+	CoachContact_GetCoachContacts_FullMethodName = "/fitness_center.coach_contact.CoachContact/GetCoachContacts"
+)
+
+// CoachContactClient is the client API for CoachContact service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachContact manages the ways to reach a coach. Every contact is shown to
+// an audience: anyone, signed-in members or staff (admins and coaches), and
+// reads only return the contacts shown to the caller. GetCoachProfile of the
+// CoachProfile service includes them too.
+type CoachContactClient interface {
+	// SetCoachContacts replaces every contact of a coach
+	SetCoachContacts(ctx context.Context, in *SetCoachContactsRequest, opts ...grpc.CallOption) (*SetCoachContactsResponse, error)
+	GetCoachContacts(ctx context.Context, in *GetCoachContactsRequest, opts ...grpc.CallOption) (*GetCoachContactsResponse, error)
+}
+
+type coachContactClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachContactClient(cc grpc.ClientConnInterface) CoachContactClient {
+	return &coachContactClient{cc}
+}
+
+func (c *coachContactClient) SetCoachContacts(ctx context.Context, in *SetCoachContactsRequest, opts ...grpc.CallOption) (*SetCoachContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCoachContactsResponse)
+	err := c.cc.Invoke(ctx, CoachContact_SetCoachContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachContactClient) GetCoachContacts(ctx context.Context, in *GetCoachContactsRequest, opts ...grpc.CallOption) (*GetCoachContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachContactsResponse)
+	err := c.cc.Invoke(ctx, CoachContact_GetCoachContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachContactServer is the server API for CoachContact service.
+// All implementations must embed UnimplementedCoachContactServer
+// for forward compatibility.
+//
+// CoachContact manages the ways to reach a coach. Every contact is shown to
+// an audience: anyone, signed-in members or staff (admins and coaches), and
+// reads only return the contacts shown to the caller. GetCoachProfile of the
+// CoachProfile service includes them too.
+type CoachContactServer interface {
+	// SetCoachContacts replaces every contact of a coach
+	SetCoachContacts(context.Context, *SetCoachContactsRequest) (*SetCoachContactsResponse, error)
+	GetCoachContacts(context.Context, *GetCoachContactsRequest) (*GetCoachContactsResponse, error)
+	mustEmbedUnimplementedCoachContactServer()
+}
+
+// UnimplementedCoachContactServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachContactServer struct{}
+
+func (UnimplementedCoachContactServer) SetCoachContacts(context.Context, *SetCoachContactsRequest) (*SetCoachContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoachContacts not implemented")
+}
+func (UnimplementedCoachContactServer) GetCoachContacts(context.Context, *GetCoachContactsRequest) (*GetCoachContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachContacts not implemented")
+}
+func (UnimplementedCoachContactServer) mustEmbedUnimplementedCoachContactServer() {}
+func (UnimplementedCoachContactServer) testEmbeddedByValue()                      {}
+
+// UnsafeCoachContactServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachContactServer will
+// result in compilation errors.
+type UnsafeCoachContactServer interface {
+	mustEmbedUnimplementedCoachContactServer()
+}
+
+func RegisterCoachContactServer(s grpc.ServiceRegistrar, srv CoachContactServer) {
+	// If the following call pancis, it indicates UnimplementedCoachContactServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachContact_ServiceDesc, srv)
+}
+
+func _CoachContact_SetCoachContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoachContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachContactServer).SetCoachContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachContact_SetCoachContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachContactServer).SetCoachContacts(ctx, req.(*SetCoachContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachContact_GetCoachContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachContactServer).GetCoachContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachContact_GetCoachContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachContactServer).GetCoachContacts(ctx, req.(*GetCoachContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachContact_ServiceDesc is the grpc.ServiceDesc for CoachContact service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachContact_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_contact.CoachContact",
+	HandlerType: (*CoachContactServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetCoachContacts",
+			Handler:    _CoachContact_SetCoachContacts_Handler,
+		},
+		{
+			MethodName: "GetCoachContacts",
+			Handler:    _CoachContact_GetCoachContacts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_contact.proto",
+}
@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: coach_favorite.proto
+
+package FitnessCenter_protobuf_coach_favorite
+
+import (
+	FitnessCenter_protobuf_coach "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// favorited is set for the favorites of the authenticated caller
+type FavoriteStatsObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId       string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	FavoriteCount int32  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	Favorited     bool   `protobuf:"varint,3,opt,name=favorited,proto3" json:"favorited,omitempty"`
+}
+
+func (x *FavoriteStatsObject) Reset() {
+	*x = FavoriteStatsObject{}
+	mi := &file_coach_favorite_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteStatsObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteStatsObject) ProtoMessage() {}
+
+func (x *FavoriteStatsObject) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteStatsObject.ProtoReflect.Descriptor instead.
+func (*FavoriteStatsObject) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FavoriteStatsObject) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *FavoriteStatsObject) GetFavoriteCount() int32 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *FavoriteStatsObject) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+type AddFavoriteCoachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *AddFavoriteCoachRequest) Reset() {
+	*x = AddFavoriteCoachRequest{}
+	mi := &file_coach_favorite_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteCoachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteCoachRequest) ProtoMessage() {}
+
+func (x *AddFavoriteCoachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteCoachRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteCoachRequest) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddFavoriteCoachRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type AddFavoriteCoachResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteStats *FavoriteStatsObject `protobuf:"bytes,1,opt,name=favoriteStats,proto3" json:"favoriteStats,omitempty"`
+}
+
+func (x *AddFavoriteCoachResponse) Reset() {
+	*x = AddFavoriteCoachResponse{}
+	mi := &file_coach_favorite_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteCoachResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteCoachResponse) ProtoMessage() {}
+
+func (x *AddFavoriteCoachResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteCoachResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteCoachResponse) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddFavoriteCoachResponse) GetFavoriteStats() *FavoriteStatsObject {
+	if x != nil {
+		return x.FavoriteStats
+	}
+	return nil
+}
+
+type RemoveFavoriteCoachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *RemoveFavoriteCoachRequest) Reset() {
+	*x = RemoveFavoriteCoachRequest{}
+	mi := &file_coach_favorite_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteCoachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteCoachRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteCoachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteCoachRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteCoachRequest) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveFavoriteCoachRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type RemoveFavoriteCoachResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteStats *FavoriteStatsObject `protobuf:"bytes,1,opt,name=favoriteStats,proto3" json:"favoriteStats,omitempty"`
+}
+
+func (x *RemoveFavoriteCoachResponse) Reset() {
+	*x = RemoveFavoriteCoachResponse{}
+	mi := &file_coach_favorite_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteCoachResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteCoachResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteCoachResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteCoachResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteCoachResponse) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveFavoriteCoachResponse) GetFavoriteStats() *FavoriteStatsObject {
+	if x != nil {
+		return x.FavoriteStats
+	}
+	return nil
+}
+
+type GetMyFavoriteCoachesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMyFavoriteCoachesRequest) Reset() {
+	*x = GetMyFavoriteCoachesRequest{}
+	mi := &file_coach_favorite_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyFavoriteCoachesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyFavoriteCoachesRequest) ProtoMessage() {}
+
+func (x *GetMyFavoriteCoachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyFavoriteCoachesRequest.ProtoReflect.Descriptor instead.
+func (*GetMyFavoriteCoachesRequest) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{5}
+}
+
+type GetMyFavoriteCoachesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachObjects []*FitnessCenter_protobuf_coach.CoachObject `protobuf:"bytes,1,rep,name=coachObjects,proto3" json:"coachObjects,omitempty"`
+}
+
+func (x *GetMyFavoriteCoachesResponse) Reset() {
+	*x = GetMyFavoriteCoachesResponse{}
+	mi := &file_coach_favorite_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyFavoriteCoachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyFavoriteCoachesResponse) ProtoMessage() {}
+
+func (x *GetMyFavoriteCoachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyFavoriteCoachesResponse.ProtoReflect.Descriptor instead.
+func (*GetMyFavoriteCoachesResponse) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMyFavoriteCoachesResponse) GetCoachObjects() []*FitnessCenter_protobuf_coach.CoachObject {
+	if x != nil {
+		return x.CoachObjects
+	}
+	return nil
+}
+
+type GetCoachFavoriteStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *GetCoachFavoriteStatsRequest) Reset() {
+	*x = GetCoachFavoriteStatsRequest{}
+	mi := &file_coach_favorite_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachFavoriteStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachFavoriteStatsRequest) ProtoMessage() {}
+
+func (x *GetCoachFavoriteStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachFavoriteStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachFavoriteStatsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCoachFavoriteStatsRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type GetCoachFavoriteStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteStats *FavoriteStatsObject `protobuf:"bytes,1,opt,name=favoriteStats,proto3" json:"favoriteStats,omitempty"`
+}
+
+func (x *GetCoachFavoriteStatsResponse) Reset() {
+	*x = GetCoachFavoriteStatsResponse{}
+	mi := &file_coach_favorite_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachFavoriteStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachFavoriteStatsResponse) ProtoMessage() {}
+
+func (x *GetCoachFavoriteStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachFavoriteStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachFavoriteStatsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCoachFavoriteStatsResponse) GetFavoriteStats() *FavoriteStatsObject {
+	if x != nil {
+		return x.FavoriteStats
+	}
+	return nil
+}
+
+type GetCoachesFavoriteStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCoachesFavoriteStatsRequest) Reset() {
+	*x = GetCoachesFavoriteStatsRequest{}
+	mi := &file_coach_favorite_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachesFavoriteStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachesFavoriteStatsRequest) ProtoMessage() {}
+
+func (x *GetCoachesFavoriteStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachesFavoriteStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachesFavoriteStatsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{9}
+}
+
+type GetCoachesFavoriteStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteStats []*FavoriteStatsObject `protobuf:"bytes,1,rep,name=favoriteStats,proto3" json:"favoriteStats,omitempty"`
+}
+
+func (x *GetCoachesFavoriteStatsResponse) Reset() {
+	*x = GetCoachesFavoriteStatsResponse{}
+	mi := &file_coach_favorite_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachesFavoriteStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachesFavoriteStatsResponse) ProtoMessage() {}
+
+func (x *GetCoachesFavoriteStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_favorite_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachesFavoriteStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachesFavoriteStatsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_favorite_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCoachesFavoriteStatsResponse) GetFavoriteStats() []*FavoriteStatsObject {
+	if x != nil {
+		return x.FavoriteStats
+	}
+	return nil
+}
+
+var File_coach_favorite_proto protoreflect.FileDescriptor
+
+var file_coach_favorite_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x1a, 0x0b, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x75, 0x0a, 0x13, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x17, 0x41, 0x64, 0x64,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x22,
+	0x74, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x77,
+	0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
+	0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x79,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x0c, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x39, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x65, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x66, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x32, 0xe6, 0x05, 0x0a, 0x0d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x13, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x12, 0x39, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x4d, 0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x3a, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3b, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x98, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3d, 0x2e, 0x66,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x55, 0x5a, 0x53, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x6e, 0x4b, 0x6f, 0x2d,
+	0x63, 0x6f, 0x64, 0x65, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x46, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_favorite_proto_rawDescOnce sync.Once
+	file_coach_favorite_proto_rawDescData = file_coach_favorite_proto_rawDesc
+)
+
+func file_coach_favorite_proto_rawDescGZIP() []byte {
+	file_coach_favorite_proto_rawDescOnce.Do(func() {
+		file_coach_favorite_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_favorite_proto_rawDescData)
+	})
+	return file_coach_favorite_proto_rawDescData
+}
+
+var file_coach_favorite_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_coach_favorite_proto_goTypes = []any{
+	(*FavoriteStatsObject)(nil),                      // 0: fitness_center.coach_favorite.FavoriteStatsObject
+	(*AddFavoriteCoachRequest)(nil),                  // 1: fitness_center.coach_favorite.AddFavoriteCoachRequest
+	(*AddFavoriteCoachResponse)(nil),                 // 2: fitness_center.coach_favorite.AddFavoriteCoachResponse
+	(*RemoveFavoriteCoachRequest)(nil),               // 3: fitness_center.coach_favorite.RemoveFavoriteCoachRequest
+	(*RemoveFavoriteCoachResponse)(nil),              // 4: fitness_center.coach_favorite.RemoveFavoriteCoachResponse
+	(*GetMyFavoriteCoachesRequest)(nil),              // 5: fitness_center.coach_favorite.GetMyFavoriteCoachesRequest
+	(*GetMyFavoriteCoachesResponse)(nil),             // 6: fitness_center.coach_favorite.GetMyFavoriteCoachesResponse
+	(*GetCoachFavoriteStatsRequest)(nil),             // 7: fitness_center.coach_favorite.GetCoachFavoriteStatsRequest
+	(*GetCoachFavoriteStatsResponse)(nil),            // 8: fitness_center.coach_favorite.GetCoachFavoriteStatsResponse
+	(*GetCoachesFavoriteStatsRequest)(nil),           // 9: fitness_center.coach_favorite.GetCoachesFavoriteStatsRequest
+	(*GetCoachesFavoriteStatsResponse)(nil),          // 10: fitness_center.coach_favorite.GetCoachesFavoriteStatsResponse
+	(*FitnessCenter_protobuf_coach.CoachObject)(nil), // 11: fitness_center.coach.CoachObject
+}
+var file_coach_favorite_proto_depIdxs = []int32{
+	0,  // 0: fitness_center.coach_favorite.AddFavoriteCoachResponse.favoriteStats:type_name -> fitness_center.coach_favorite.FavoriteStatsObject
+	0,  // 1: fitness_center.coach_favorite.RemoveFavoriteCoachResponse.favoriteStats:type_name -> fitness_center.coach_favorite.FavoriteStatsObject
+	11, // 2: fitness_center.coach_favorite.GetMyFavoriteCoachesResponse.coachObjects:type_name -> fitness_center.coach.CoachObject
+	0,  // 3: fitness_center.coach_favorite.GetCoachFavoriteStatsResponse.favoriteStats:type_name -> fitness_center.coach_favorite.FavoriteStatsObject
+	0,  // 4: fitness_center.coach_favorite.GetCoachesFavoriteStatsResponse.favoriteStats:type_name -> fitness_center.coach_favorite.FavoriteStatsObject
+	1,  // 5: fitness_center.coach_favorite.CoachFavorite.AddFavoriteCoach:input_type -> fitness_center.coach_favorite.AddFavoriteCoachRequest
+	3,  // 6: fitness_center.coach_favorite.CoachFavorite.RemoveFavoriteCoach:input_type -> fitness_center.coach_favorite.RemoveFavoriteCoachRequest
+	5,  // 7: fitness_center.coach_favorite.CoachFavorite.GetMyFavoriteCoaches:input_type -> fitness_center.coach_favorite.GetMyFavoriteCoachesRequest
+	7,  // 8: fitness_center.coach_favorite.CoachFavorite.GetCoachFavoriteStats:input_type -> fitness_center.coach_favorite.GetCoachFavoriteStatsRequest
+	9,  // 9: fitness_center.coach_favorite.CoachFavorite.GetCoachesFavoriteStats:input_type -> fitness_center.coach_favorite.GetCoachesFavoriteStatsRequest
+	2,  // 10: fitness_center.coach_favorite.CoachFavorite.AddFavoriteCoach:output_type -> fitness_center.coach_favorite.AddFavoriteCoachResponse
+	4,  // 11: fitness_center.coach_favorite.CoachFavorite.RemoveFavoriteCoach:output_type -> fitness_center.coach_favorite.RemoveFavoriteCoachResponse
+	6,  // 12: fitness_center.coach_favorite.CoachFavorite.GetMyFavoriteCoaches:output_type -> fitness_center.coach_favorite.GetMyFavoriteCoachesResponse
+	8,  // 13: fitness_center.coach_favorite.CoachFavorite.GetCoachFavoriteStats:output_type -> fitness_center.coach_favorite.GetCoachFavoriteStatsResponse
+	10, // 14: fitness_center.coach_favorite.CoachFavorite.GetCoachesFavoriteStats:output_type -> fitness_center.coach_favorite.GetCoachesFavoriteStatsResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_coach_favorite_proto_init() }
+func file_coach_favorite_proto_init() {
+	if File_coach_favorite_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_favorite_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_favorite_proto_goTypes,
+		DependencyIndexes: file_coach_favorite_proto_depIdxs,
+		MessageInfos:      file_coach_favorite_proto_msgTypes,
+	}.Build()
+	File_coach_favorite_proto = out.File
+	file_coach_favorite_proto_rawDesc = nil
+	file_coach_favorite_proto_goTypes = nil
+	file_coach_favorite_proto_depIdxs = nil
+}
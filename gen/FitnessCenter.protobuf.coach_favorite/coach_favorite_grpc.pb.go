@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_favorite.proto
+
+package FitnessCenter_protobuf_coach_favorite
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachFavorite_AddFavoriteCoach_FullMethodName        = "/fitness_center.coach_favorite.CoachFavorite/AddFavoriteCoach"
+	CoachFavorite_RemoveFavoriteCoach_FullMethodName     = "/fitness_center.coach_favorite.CoachFavorite/RemoveFavoriteCoach"
+	CoachFavorite_GetMyFavoriteCoaches_FullMethodName    = "/fitness_center.coach_favorite.CoachFavorite/GetMyFavoriteCoaches"
+	CoachFavorite_GetCoachFavoriteStats_FullMethodName   = "/fitness_center.coach_favorite.CoachFavorite/GetCoachFavoriteStats"
+	CoachFavorite_GetCoachesFavoriteStats_FullMethodName = "/fitness_center.coach_favorite.CoachFavorite/GetCoachesFavoriteStats"
+)
+
+// CoachFavoriteClient is the client API for CoachFavorite service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachFavorite keeps the coaches members favorited. GetCoachProfile of the
+// CoachProfile service includes the favorite stats too, and listings put the
+// favorites of an authenticated caller first through the x-sort-by metadata:
+// favorites.
+type CoachFavoriteClient interface {
+	// AddFavoriteCoach and RemoveFavoriteCoach change the favorites of the
+	// authenticated user, who must be known to the User service; they succeed
+	// when the coach already is or isn't a favorite
+	AddFavoriteCoach(ctx context.Context, in *AddFavoriteCoachRequest, opts ...grpc.CallOption) (*AddFavoriteCoachResponse, error)
+	RemoveFavoriteCoach(ctx context.Context, in *RemoveFavoriteCoachRequest, opts ...grpc.CallOption) (*RemoveFavoriteCoachResponse, error)
+	GetMyFavoriteCoaches(ctx context.Context, in *GetMyFavoriteCoachesRequest, opts ...grpc.CallOption) (*GetMyFavoriteCoachesResponse, error)
+	GetCoachFavoriteStats(ctx context.Context, in *GetCoachFavoriteStatsRequest, opts ...grpc.CallOption) (*GetCoachFavoriteStatsResponse, error)
+	// GetCoachesFavoriteStats returns the stats of the coaches GetCoaches
+	// lists, in the same order and for the same x-location-id and x-sort-by
+	// metadata
+	GetCoachesFavoriteStats(ctx context.Context, in *GetCoachesFavoriteStatsRequest, opts ...grpc.CallOption) (*GetCoachesFavoriteStatsResponse, error)
+}
+
+type coachFavoriteClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachFavoriteClient(cc grpc.ClientConnInterface) CoachFavoriteClient {
+	return &coachFavoriteClient{cc}
+}
+
+func (c *coachFavoriteClient) AddFavoriteCoach(ctx context.Context, in *AddFavoriteCoachRequest, opts ...grpc.CallOption) (*AddFavoriteCoachResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFavoriteCoachResponse)
+	err := c.cc.Invoke(ctx, CoachFavorite_AddFavoriteCoach_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachFavoriteClient) RemoveFavoriteCoach(ctx context.Context, in *RemoveFavoriteCoachRequest, opts ...grpc.CallOption) (*RemoveFavoriteCoachResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFavoriteCoachResponse)
+	err := c.cc.Invoke(ctx, CoachFavorite_RemoveFavoriteCoach_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachFavoriteClient) GetMyFavoriteCoaches(ctx context.Context, in *GetMyFavoriteCoachesRequest, opts ...grpc.CallOption) (*GetMyFavoriteCoachesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyFavoriteCoachesResponse)
+	err := c.cc.Invoke(ctx, CoachFavorite_GetMyFavoriteCoaches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachFavoriteClient) GetCoachFavoriteStats(ctx context.Context, in *GetCoachFavoriteStatsRequest, opts ...grpc.CallOption) (*GetCoachFavoriteStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachFavoriteStatsResponse)
+	err := c.cc.Invoke(ctx, CoachFavorite_GetCoachFavoriteStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachFavoriteClient) GetCoachesFavoriteStats(ctx context.Context, in *GetCoachesFavoriteStatsRequest, opts ...grpc.CallOption) (*GetCoachesFavoriteStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachesFavoriteStatsResponse)
+	err := c.cc.Invoke(ctx, CoachFavorite_GetCoachesFavoriteStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachFavoriteServer is the server API for CoachFavorite service.
+// All implementations must embed UnimplementedCoachFavoriteServer
+// for forward compatibility.
+//
+// CoachFavorite keeps the coaches members favorited. GetCoachProfile of the
+// CoachProfile service includes the favorite stats too, and listings put the
+// favorites of an authenticated caller first through the x-sort-by metadata:
+// favorites.
+type CoachFavoriteServer interface {
+	// AddFavoriteCoach and RemoveFavoriteCoach change the favorites of the
+	// authenticated user, who must be known to the User service; they succeed
+	// when the coach already is or isn't a favorite
+	AddFavoriteCoach(context.Context, *AddFavoriteCoachRequest) (*AddFavoriteCoachResponse, error)
+	RemoveFavoriteCoach(context.Context, *RemoveFavoriteCoachRequest) (*RemoveFavoriteCoachResponse, error)
+	GetMyFavoriteCoaches(context.Context, *GetMyFavoriteCoachesRequest) (*GetMyFavoriteCoachesResponse, error)
+	GetCoachFavoriteStats(context.Context, *GetCoachFavoriteStatsRequest) (*GetCoachFavoriteStatsResponse, error)
+	// GetCoachesFavoriteStats returns the stats of the coaches GetCoaches
+	// lists, in the same order and for the same x-location-id and x-sort-by
+	// metadata
+	GetCoachesFavoriteStats(context.Context, *GetCoachesFavoriteStatsRequest) (*GetCoachesFavoriteStatsResponse, error)
+	mustEmbedUnimplementedCoachFavoriteServer()
+}
+
+// UnimplementedCoachFavoriteServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachFavoriteServer struct{}
+
+func (UnimplementedCoachFavoriteServer) AddFavoriteCoach(context.Context, *AddFavoriteCoachRequest) (*AddFavoriteCoachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteCoach not implemented")
+}
+func (UnimplementedCoachFavoriteServer) RemoveFavoriteCoach(context.Context, *RemoveFavoriteCoachRequest) (*RemoveFavoriteCoachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteCoach not implemented")
+}
+func (UnimplementedCoachFavoriteServer) GetMyFavoriteCoaches(context.Context, *GetMyFavoriteCoachesRequest) (*GetMyFavoriteCoachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyFavoriteCoaches not implemented")
+}
+func (UnimplementedCoachFavoriteServer) GetCoachFavoriteStats(context.Context, *GetCoachFavoriteStatsRequest) (*GetCoachFavoriteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachFavoriteStats not implemented")
+}
+func (UnimplementedCoachFavoriteServer) GetCoachesFavoriteStats(context.Context, *GetCoachesFavoriteStatsRequest) (*GetCoachesFavoriteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachesFavoriteStats not implemented")
+}
+func (UnimplementedCoachFavoriteServer) mustEmbedUnimplementedCoachFavoriteServer() {}
+func (UnimplementedCoachFavoriteServer) testEmbeddedByValue()                       {}
+
+// UnsafeCoachFavoriteServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachFavoriteServer will
+// result in compilation errors.
+type UnsafeCoachFavoriteServer interface {
+	mustEmbedUnimplementedCoachFavoriteServer()
+}
+
+func RegisterCoachFavoriteServer(s grpc.ServiceRegistrar, srv CoachFavoriteServer) {
+	// If the following call pancis, it indicates UnimplementedCoachFavoriteServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachFavorite_ServiceDesc, srv)
+}
+
+func _CoachFavorite_AddFavoriteCoach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteCoachRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachFavoriteServer).AddFavoriteCoach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachFavorite_AddFavoriteCoach_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachFavoriteServer).AddFavoriteCoach(ctx, req.(*AddFavoriteCoachRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachFavorite_RemoveFavoriteCoach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteCoachRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachFavoriteServer).RemoveFavoriteCoach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachFavorite_RemoveFavoriteCoach_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachFavoriteServer).RemoveFavoriteCoach(ctx, req.(*RemoveFavoriteCoachRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachFavorite_GetMyFavoriteCoaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyFavoriteCoachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachFavoriteServer).GetMyFavoriteCoaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachFavorite_GetMyFavoriteCoaches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachFavoriteServer).GetMyFavoriteCoaches(ctx, req.(*GetMyFavoriteCoachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachFavorite_GetCoachFavoriteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachFavoriteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachFavoriteServer).GetCoachFavoriteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachFavorite_GetCoachFavoriteStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachFavoriteServer).GetCoachFavoriteStats(ctx, req.(*GetCoachFavoriteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachFavorite_GetCoachesFavoriteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachesFavoriteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachFavoriteServer).GetCoachesFavoriteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachFavorite_GetCoachesFavoriteStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachFavoriteServer).GetCoachesFavoriteStats(ctx, req.(*GetCoachesFavoriteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachFavorite_ServiceDesc is the grpc.ServiceDesc for CoachFavorite service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachFavorite_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_favorite.CoachFavorite",
+	HandlerType: (*CoachFavoriteServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddFavoriteCoach",
+			Handler:    _CoachFavorite_AddFavoriteCoach_Handler,
+		},
+		{
+			MethodName: "RemoveFavoriteCoach",
+			Handler:    _CoachFavorite_RemoveFavoriteCoach_Handler,
+		},
+		{
+			MethodName: "GetMyFavoriteCoaches",
+			Handler:    _CoachFavorite_GetMyFavoriteCoaches_Handler,
+		},
+		{
+			MethodName: "GetCoachFavoriteStats",
+			Handler:    _CoachFavorite_GetCoachFavoriteStats_Handler,
+		},
+		{
+			MethodName: "GetCoachesFavoriteStats",
+			Handler:    _CoachFavorite_GetCoachesFavoriteStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_favorite.proto",
+}
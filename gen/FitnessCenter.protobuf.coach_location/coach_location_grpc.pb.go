@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_location.proto
+
+package FitnessCenter_protobuf_coach_location
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachLocation_CreateLocation_FullMethodName     = "/fitness_center.coach_location.CoachLocation/CreateLocation"
+	CoachLocation_GetLocationById_FullMethodName    = "/fitness_center.coach_location.CoachLocation/GetLocationById"
+	CoachLocation_UpdateLocation_FullMethodName     = "/fitness_center.coach_location.CoachLocation/UpdateLocation"
+	CoachLocation_DeleteLocationById_FullMethodName = "/fitness_center.coach_location.CoachLocation/DeleteLocationById"
+	CoachLocation_GetLocations_FullMethodName       = "/fitness_center.coach_location.CoachLocation/GetLocations"
+	CoachLocation_SetCoachLocations_FullMethodName  = "/fitness_center.coach_location.CoachLocation/SetCoachLocations"
+	CoachLocation_GetCoachLocations_FullMethodName  = "/fitness_center.coach_location.CoachLocation/GetCoachLocations"
+	CoachLocation_CheckCoachLocation_FullMethodName = "/fitness_center.coach_location.CoachLocation/CheckCoachLocation"
+)
+
+// CoachLocationClient is the client API for CoachLocation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachLocation manages the clubs of the fitness center and the coaches
+// working at them. GetCoaches and GetCoachesWithServicesWithReviewsWithUsers
+// of the Coach service list only the coaches of a location when its id is
+// sent in the "x-location-id" request metadata.
+type CoachLocationClient interface {
+	CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*CreateLocationResponse, error)
+	GetLocationById(ctx context.Context, in *GetLocationByIdRequest, opts ...grpc.CallOption) (*GetLocationByIdResponse, error)
+	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*UpdateLocationResponse, error)
+	DeleteLocationById(ctx context.Context, in *DeleteLocationByIdRequest, opts ...grpc.CallOption) (*DeleteLocationByIdResponse, error)
+	GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error)
+	SetCoachLocations(ctx context.Context, in *SetCoachLocationsRequest, opts ...grpc.CallOption) (*SetCoachLocationsResponse, error)
+	GetCoachLocations(ctx context.Context, in *GetCoachLocationsRequest, opts ...grpc.CallOption) (*GetCoachLocationsResponse, error)
+	// CheckCoachLocation tells whether a coach works at a location, so that
+	// the services scheduling coach availability and sessions only accept
+	// locations the coach is assigned to
+	CheckCoachLocation(ctx context.Context, in *CheckCoachLocationRequest, opts ...grpc.CallOption) (*CheckCoachLocationResponse, error)
+}
+
+type coachLocationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachLocationClient(cc grpc.ClientConnInterface) CoachLocationClient {
+	return &coachLocationClient{cc}
+}
+
+func (c *coachLocationClient) CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*CreateLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLocationResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_CreateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) GetLocationById(ctx context.Context, in *GetLocationByIdRequest, opts ...grpc.CallOption) (*GetLocationByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationByIdResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_GetLocationById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*UpdateLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLocationResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_UpdateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) DeleteLocationById(ctx context.Context, in *DeleteLocationByIdRequest, opts ...grpc.CallOption) (*DeleteLocationByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLocationByIdResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_DeleteLocationById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) GetLocations(ctx context.Context, in *GetLocationsRequest, opts ...grpc.CallOption) (*GetLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationsResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_GetLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) SetCoachLocations(ctx context.Context, in *SetCoachLocationsRequest, opts ...grpc.CallOption) (*SetCoachLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCoachLocationsResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_SetCoachLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) GetCoachLocations(ctx context.Context, in *GetCoachLocationsRequest, opts ...grpc.CallOption) (*GetCoachLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachLocationsResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_GetCoachLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachLocationClient) CheckCoachLocation(ctx context.Context, in *CheckCoachLocationRequest, opts ...grpc.CallOption) (*CheckCoachLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCoachLocationResponse)
+	err := c.cc.Invoke(ctx, CoachLocation_CheckCoachLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachLocationServer is the server API for CoachLocation service.
+// All implementations must embed UnimplementedCoachLocationServer
+// for forward compatibility.
+//
+// CoachLocation manages the clubs of the fitness center and the coaches
+// working at them. GetCoaches and GetCoachesWithServicesWithReviewsWithUsers
+// of the Coach service list only the coaches of a location when its id is
+// sent in the "x-location-id" request metadata.
+type CoachLocationServer interface {
+	CreateLocation(context.Context, *CreateLocationRequest) (*CreateLocationResponse, error)
+	GetLocationById(context.Context, *GetLocationByIdRequest) (*GetLocationByIdResponse, error)
+	UpdateLocation(context.Context, *UpdateLocationRequest) (*UpdateLocationResponse, error)
+	DeleteLocationById(context.Context, *DeleteLocationByIdRequest) (*DeleteLocationByIdResponse, error)
+	GetLocations(context.Context, *GetLocationsRequest) (*GetLocationsResponse, error)
+	SetCoachLocations(context.Context, *SetCoachLocationsRequest) (*SetCoachLocationsResponse, error)
+	GetCoachLocations(context.Context, *GetCoachLocationsRequest) (*GetCoachLocationsResponse, error)
+	// CheckCoachLocation tells whether a coach works at a location, so that
+	// the services scheduling coach availability and sessions only accept
+	// locations the coach is assigned to
+	CheckCoachLocation(context.Context, *CheckCoachLocationRequest) (*CheckCoachLocationResponse, error)
+	mustEmbedUnimplementedCoachLocationServer()
+}
+
+// UnimplementedCoachLocationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachLocationServer struct{}
+
+func (UnimplementedCoachLocationServer) CreateLocation(context.Context, *CreateLocationRequest) (*CreateLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocation not implemented")
+}
+func (UnimplementedCoachLocationServer) GetLocationById(context.Context, *GetLocationByIdRequest) (*GetLocationByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationById not implemented")
+}
+func (UnimplementedCoachLocationServer) UpdateLocation(context.Context, *UpdateLocationRequest) (*UpdateLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocation not implemented")
+}
+func (UnimplementedCoachLocationServer) DeleteLocationById(context.Context, *DeleteLocationByIdRequest) (*DeleteLocationByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLocationById not implemented")
+}
+func (UnimplementedCoachLocationServer) GetLocations(context.Context, *GetLocationsRequest) (*GetLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocations not implemented")
+}
+func (UnimplementedCoachLocationServer) SetCoachLocations(context.Context, *SetCoachLocationsRequest) (*SetCoachLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoachLocations not implemented")
+}
+func (UnimplementedCoachLocationServer) GetCoachLocations(context.Context, *GetCoachLocationsRequest) (*GetCoachLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachLocations not implemented")
+}
+func (UnimplementedCoachLocationServer) CheckCoachLocation(context.Context, *CheckCoachLocationRequest) (*CheckCoachLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCoachLocation not implemented")
+}
+func (UnimplementedCoachLocationServer) mustEmbedUnimplementedCoachLocationServer() {}
+func (UnimplementedCoachLocationServer) testEmbeddedByValue()                       {}
+
+// UnsafeCoachLocationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachLocationServer will
+// result in compilation errors.
+type UnsafeCoachLocationServer interface {
+	mustEmbedUnimplementedCoachLocationServer()
+}
+
+func RegisterCoachLocationServer(s grpc.ServiceRegistrar, srv CoachLocationServer) {
+	// If the following call pancis, it indicates UnimplementedCoachLocationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachLocation_ServiceDesc, srv)
+}
+
+func _CoachLocation_CreateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).CreateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_CreateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).CreateLocation(ctx, req.(*CreateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_GetLocationById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).GetLocationById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_GetLocationById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).GetLocationById(ctx, req.(*GetLocationByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_UpdateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).UpdateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_UpdateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).UpdateLocation(ctx, req.(*UpdateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_DeleteLocationById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLocationByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).DeleteLocationById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_DeleteLocationById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).DeleteLocationById(ctx, req.(*DeleteLocationByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_GetLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).GetLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_GetLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).GetLocations(ctx, req.(*GetLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_SetCoachLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoachLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).SetCoachLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_SetCoachLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).SetCoachLocations(ctx, req.(*SetCoachLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_GetCoachLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).GetCoachLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_GetCoachLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).GetCoachLocations(ctx, req.(*GetCoachLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachLocation_CheckCoachLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCoachLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachLocationServer).CheckCoachLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachLocation_CheckCoachLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachLocationServer).CheckCoachLocation(ctx, req.(*CheckCoachLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachLocation_ServiceDesc is the grpc.ServiceDesc for CoachLocation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachLocation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_location.CoachLocation",
+	HandlerType: (*CoachLocationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLocation",
+			Handler:    _CoachLocation_CreateLocation_Handler,
+		},
+		{
+			MethodName: "GetLocationById",
+			Handler:    _CoachLocation_GetLocationById_Handler,
+		},
+		{
+			MethodName: "UpdateLocation",
+			Handler:    _CoachLocation_UpdateLocation_Handler,
+		},
+		{
+			MethodName: "DeleteLocationById",
+			Handler:    _CoachLocation_DeleteLocationById_Handler,
+		},
+		{
+			MethodName: "GetLocations",
+			Handler:    _CoachLocation_GetLocations_Handler,
+		},
+		{
+			MethodName: "SetCoachLocations",
+			Handler:    _CoachLocation_SetCoachLocations_Handler,
+		},
+		{
+			MethodName: "GetCoachLocations",
+			Handler:    _CoachLocation_GetCoachLocations_Handler,
+		},
+		{
+			MethodName: "CheckCoachLocation",
+			Handler:    _CoachLocation_CheckCoachLocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_location.proto",
+}
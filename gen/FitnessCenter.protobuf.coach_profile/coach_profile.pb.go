@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: coach_profile.proto
+
+package FitnessCenter_protobuf_coach_profile
+
+import (
+	FitnessCenter_protobuf_coach_contact "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_contact"
+	FitnessCenter_protobuf_coach_favorite "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_favorite"
+	FitnessCenter_protobuf_coach_review_stats "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_review_stats"
+	FitnessCenter_protobuf_coach "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetCoachProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCoachProfileRequest) Reset() {
+	*x = GetCoachProfileRequest{}
+	mi := &file_coach_profile_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachProfileRequest) ProtoMessage() {}
+
+func (x *GetCoachProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachProfileRequest) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCoachProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCoachProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachProfile *FitnessCenter_protobuf_coach.CoachWithServicesWithReviewsWithUsers `protobuf:"bytes,1,opt,name=coachProfile,proto3" json:"coachProfile,omitempty"`
+	// The contacts shown to the caller
+	Contacts      []*FitnessCenter_protobuf_coach_contact.CoachContactObject   `protobuf:"bytes,2,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	ReviewStats   *FitnessCenter_protobuf_coach_review_stats.ReviewStatsObject `protobuf:"bytes,3,opt,name=reviewStats,proto3" json:"reviewStats,omitempty"`
+	FavoriteStats *FitnessCenter_protobuf_coach_favorite.FavoriteStatsObject   `protobuf:"bytes,4,opt,name=favoriteStats,proto3" json:"favoriteStats,omitempty"`
+}
+
+func (x *GetCoachProfileResponse) Reset() {
+	*x = GetCoachProfileResponse{}
+	mi := &file_coach_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachProfileResponse) ProtoMessage() {}
+
+func (x *GetCoachProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachProfileResponse) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCoachProfileResponse) GetCoachProfile() *FitnessCenter_protobuf_coach.CoachWithServicesWithReviewsWithUsers {
+	if x != nil {
+		return x.CoachProfile
+	}
+	return nil
+}
+
+func (x *GetCoachProfileResponse) GetContacts() []*FitnessCenter_protobuf_coach_contact.CoachContactObject {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *GetCoachProfileResponse) GetReviewStats() *FitnessCenter_protobuf_coach_review_stats.ReviewStatsObject {
+	if x != nil {
+		return x.ReviewStats
+	}
+	return nil
+}
+
+func (x *GetCoachProfileResponse) GetFavoriteStats() *FitnessCenter_protobuf_coach_favorite.FavoriteStatsObject {
+	if x != nil {
+		return x.FavoriteStats
+	}
+	return nil
+}
+
+type LinkCoachUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *LinkCoachUserRequest) Reset() {
+	*x = LinkCoachUserRequest{}
+	mi := &file_coach_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkCoachUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkCoachUserRequest) ProtoMessage() {}
+
+func (x *LinkCoachUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkCoachUserRequest.ProtoReflect.Descriptor instead.
+func (*LinkCoachUserRequest) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LinkCoachUserRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *LinkCoachUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LinkCoachUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *LinkCoachUserResponse) Reset() {
+	*x = LinkCoachUserResponse{}
+	mi := &file_coach_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkCoachUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkCoachUserResponse) ProtoMessage() {}
+
+func (x *LinkCoachUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkCoachUserResponse.ProtoReflect.Descriptor instead.
+func (*LinkCoachUserResponse) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LinkCoachUserResponse) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *LinkCoachUserResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnlinkCoachUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *UnlinkCoachUserRequest) Reset() {
+	*x = UnlinkCoachUserRequest{}
+	mi := &file_coach_profile_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkCoachUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkCoachUserRequest) ProtoMessage() {}
+
+func (x *UnlinkCoachUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkCoachUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkCoachUserRequest) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnlinkCoachUserRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type UnlinkCoachUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlinkCoachUserResponse) Reset() {
+	*x = UnlinkCoachUserResponse{}
+	mi := &file_coach_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkCoachUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkCoachUserResponse) ProtoMessage() {}
+
+func (x *UnlinkCoachUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkCoachUserResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkCoachUserResponse) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{5}
+}
+
+type GetMyCoachProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMyCoachProfileRequest) Reset() {
+	*x = GetMyCoachProfileRequest{}
+	mi := &file_coach_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyCoachProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyCoachProfileRequest) ProtoMessage() {}
+
+func (x *GetMyCoachProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyCoachProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMyCoachProfileRequest) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{6}
+}
+
+type GetMyCoachProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachProfile *FitnessCenter_protobuf_coach.CoachWithServicesWithReviewsWithUsers `protobuf:"bytes,1,opt,name=coachProfile,proto3" json:"coachProfile,omitempty"`
+	Contacts     []*FitnessCenter_protobuf_coach_contact.CoachContactObject          `protobuf:"bytes,2,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *GetMyCoachProfileResponse) Reset() {
+	*x = GetMyCoachProfileResponse{}
+	mi := &file_coach_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyCoachProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyCoachProfileResponse) ProtoMessage() {}
+
+func (x *GetMyCoachProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyCoachProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetMyCoachProfileResponse) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMyCoachProfileResponse) GetCoachProfile() *FitnessCenter_protobuf_coach.CoachWithServicesWithReviewsWithUsers {
+	if x != nil {
+		return x.CoachProfile
+	}
+	return nil
+}
+
+func (x *GetMyCoachProfileResponse) GetContacts() []*FitnessCenter_protobuf_coach_contact.CoachContactObject {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+// Empty fields are left unchanged
+type MyCoachProfileData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *MyCoachProfileData) Reset() {
+	*x = MyCoachProfileData{}
+	mi := &file_coach_profile_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyCoachProfileData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyCoachProfileData) ProtoMessage() {}
+
+func (x *MyCoachProfileData) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyCoachProfileData.ProtoReflect.Descriptor instead.
+func (*MyCoachProfileData) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MyCoachProfileData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateMyCoachProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*UpdateMyCoachProfileRequest_Data
+	//	*UpdateMyCoachProfileRequest_PhotoChunk
+	Payload isUpdateMyCoachProfileRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *UpdateMyCoachProfileRequest) Reset() {
+	*x = UpdateMyCoachProfileRequest{}
+	mi := &file_coach_profile_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyCoachProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyCoachProfileRequest) ProtoMessage() {}
+
+func (x *UpdateMyCoachProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyCoachProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyCoachProfileRequest) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *UpdateMyCoachProfileRequest) GetPayload() isUpdateMyCoachProfileRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *UpdateMyCoachProfileRequest) GetData() *MyCoachProfileData {
+	if x, ok := x.GetPayload().(*UpdateMyCoachProfileRequest_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateMyCoachProfileRequest) GetPhotoChunk() []byte {
+	if x, ok := x.GetPayload().(*UpdateMyCoachProfileRequest_PhotoChunk); ok {
+		return x.PhotoChunk
+	}
+	return nil
+}
+
+type isUpdateMyCoachProfileRequest_Payload interface {
+	isUpdateMyCoachProfileRequest_Payload()
+}
+
+type UpdateMyCoachProfileRequest_Data struct {
+	Data *MyCoachProfileData `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+type UpdateMyCoachProfileRequest_PhotoChunk struct {
+	PhotoChunk []byte `protobuf:"bytes,2,opt,name=photo_chunk,json=photoChunk,proto3,oneof"`
+}
+
+func (*UpdateMyCoachProfileRequest_Data) isUpdateMyCoachProfileRequest_Payload() {}
+
+func (*UpdateMyCoachProfileRequest_PhotoChunk) isUpdateMyCoachProfileRequest_Payload() {}
+
+type UpdateMyCoachProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coach *FitnessCenter_protobuf_coach.CoachObject `protobuf:"bytes,1,opt,name=coach,proto3" json:"coach,omitempty"`
+}
+
+func (x *UpdateMyCoachProfileResponse) Reset() {
+	*x = UpdateMyCoachProfileResponse{}
+	mi := &file_coach_profile_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyCoachProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyCoachProfileResponse) ProtoMessage() {}
+
+func (x *UpdateMyCoachProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_profile_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyCoachProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMyCoachProfileResponse) Descriptor() ([]byte, []int) {
+	return file_coach_profile_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMyCoachProfileResponse) GetCoach() *FitnessCenter_protobuf_coach.CoachObject {
+	if x != nil {
+		return x.Coach
+	}
+	return nil
+}
+
+var File_coach_profile_proto protoreflect.FileDescriptor
+
+var file_coach_profile_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x1a, 0x0b, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x13, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xfa, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x63,
+	0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x0c,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x4c, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x0b, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x58, 0x0a, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x14,
+	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xca, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x0c, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x0c, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x4c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x36,
+	0x0a, 0x12, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x57, 0x0a, 0x1c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x32, 0xa1, 0x05, 0x0a, 0x0c, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x7e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7e, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x84, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x36, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x39, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x79, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x42, 0x54, 0x5a, 0x52, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x6e, 0x4b, 0x6f, 0x2d, 0x63, 0x6f,
+	0x64, 0x65, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_profile_proto_rawDescOnce sync.Once
+	file_coach_profile_proto_rawDescData = file_coach_profile_proto_rawDesc
+)
+
+func file_coach_profile_proto_rawDescGZIP() []byte {
+	file_coach_profile_proto_rawDescOnce.Do(func() {
+		file_coach_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_profile_proto_rawDescData)
+	})
+	return file_coach_profile_proto_rawDescData
+}
+
+var file_coach_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_coach_profile_proto_goTypes = []any{
+	(*GetCoachProfileRequest)(nil),                                             // 0: fitness_center.coach_profile.GetCoachProfileRequest
+	(*GetCoachProfileResponse)(nil),                                            // 1: fitness_center.coach_profile.GetCoachProfileResponse
+	(*LinkCoachUserRequest)(nil),                                               // 2: fitness_center.coach_profile.LinkCoachUserRequest
+	(*LinkCoachUserResponse)(nil),                                              // 3: fitness_center.coach_profile.LinkCoachUserResponse
+	(*UnlinkCoachUserRequest)(nil),                                             // 4: fitness_center.coach_profile.UnlinkCoachUserRequest
+	(*UnlinkCoachUserResponse)(nil),                                            // 5: fitness_center.coach_profile.UnlinkCoachUserResponse
+	(*GetMyCoachProfileRequest)(nil),                                           // 6: fitness_center.coach_profile.GetMyCoachProfileRequest
+	(*GetMyCoachProfileResponse)(nil),                                          // 7: fitness_center.coach_profile.GetMyCoachProfileResponse
+	(*MyCoachProfileData)(nil),                                                 // 8: fitness_center.coach_profile.MyCoachProfileData
+	(*UpdateMyCoachProfileRequest)(nil),                                        // 9: fitness_center.coach_profile.UpdateMyCoachProfileRequest
+	(*UpdateMyCoachProfileResponse)(nil),                                       // 10: fitness_center.coach_profile.UpdateMyCoachProfileResponse
+	(*FitnessCenter_protobuf_coach.CoachWithServicesWithReviewsWithUsers)(nil), // 11: fitness_center.coach.CoachWithServicesWithReviewsWithUsers
+	(*FitnessCenter_protobuf_coach_contact.CoachContactObject)(nil),            // 12: fitness_center.coach_contact.CoachContactObject
+	(*FitnessCenter_protobuf_coach_review_stats.ReviewStatsObject)(nil),        // 13: fitness_center.coach_review_stats.ReviewStatsObject
+	(*FitnessCenter_protobuf_coach_favorite.FavoriteStatsObject)(nil),          // 14: fitness_center.coach_favorite.FavoriteStatsObject
+	(*FitnessCenter_protobuf_coach.CoachObject)(nil),                           // 15: fitness_center.coach.CoachObject
+}
+var file_coach_profile_proto_depIdxs = []int32{
+	11, // 0: fitness_center.coach_profile.GetCoachProfileResponse.coachProfile:type_name -> fitness_center.coach.CoachWithServicesWithReviewsWithUsers
+	12, // 1: fitness_center.coach_profile.GetCoachProfileResponse.contacts:type_name -> fitness_center.coach_contact.CoachContactObject
+	13, // 2: fitness_center.coach_profile.GetCoachProfileResponse.reviewStats:type_name -> fitness_center.coach_review_stats.ReviewStatsObject
+	14, // 3: fitness_center.coach_profile.GetCoachProfileResponse.favoriteStats:type_name -> fitness_center.coach_favorite.FavoriteStatsObject
+	11, // 4: fitness_center.coach_profile.GetMyCoachProfileResponse.coachProfile:type_name -> fitness_center.coach.CoachWithServicesWithReviewsWithUsers
+	12, // 5: fitness_center.coach_profile.GetMyCoachProfileResponse.contacts:type_name -> fitness_center.coach_contact.CoachContactObject
+	8,  // 6: fitness_center.coach_profile.UpdateMyCoachProfileRequest.data:type_name -> fitness_center.coach_profile.MyCoachProfileData
+	15, // 7: fitness_center.coach_profile.UpdateMyCoachProfileResponse.coach:type_name -> fitness_center.coach.CoachObject
+	0,  // 8: fitness_center.coach_profile.CoachProfile.GetCoachProfile:input_type -> fitness_center.coach_profile.GetCoachProfileRequest
+	2,  // 9: fitness_center.coach_profile.CoachProfile.LinkCoachUser:input_type -> fitness_center.coach_profile.LinkCoachUserRequest
+	4,  // 10: fitness_center.coach_profile.CoachProfile.UnlinkCoachUser:input_type -> fitness_center.coach_profile.UnlinkCoachUserRequest
+	6,  // 11: fitness_center.coach_profile.CoachProfile.GetMyCoachProfile:input_type -> fitness_center.coach_profile.GetMyCoachProfileRequest
+	9,  // 12: fitness_center.coach_profile.CoachProfile.UpdateMyCoachProfile:input_type -> fitness_center.coach_profile.UpdateMyCoachProfileRequest
+	1,  // 13: fitness_center.coach_profile.CoachProfile.GetCoachProfile:output_type -> fitness_center.coach_profile.GetCoachProfileResponse
+	3,  // 14: fitness_center.coach_profile.CoachProfile.LinkCoachUser:output_type -> fitness_center.coach_profile.LinkCoachUserResponse
+	5,  // 15: fitness_center.coach_profile.CoachProfile.UnlinkCoachUser:output_type -> fitness_center.coach_profile.UnlinkCoachUserResponse
+	7,  // 16: fitness_center.coach_profile.CoachProfile.GetMyCoachProfile:output_type -> fitness_center.coach_profile.GetMyCoachProfileResponse
+	10, // 17: fitness_center.coach_profile.CoachProfile.UpdateMyCoachProfile:output_type -> fitness_center.coach_profile.UpdateMyCoachProfileResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_coach_profile_proto_init() }
+func file_coach_profile_proto_init() {
+	if File_coach_profile_proto != nil {
+		return
+	}
+	file_coach_profile_proto_msgTypes[9].OneofWrappers = []any{
+		(*UpdateMyCoachProfileRequest_Data)(nil),
+		(*UpdateMyCoachProfileRequest_PhotoChunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_profile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_profile_proto_goTypes,
+		DependencyIndexes: file_coach_profile_proto_depIdxs,
+		MessageInfos:      file_coach_profile_proto_msgTypes,
+	}.Build()
+	File_coach_profile_proto = out.File
+	file_coach_profile_proto_rawDesc = nil
+	file_coach_profile_proto_goTypes = nil
+	file_coach_profile_proto_depIdxs = nil
+}
@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_profile.proto
+
+package FitnessCenter_protobuf_coach_profile
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachProfile_GetCoachProfile_FullMethodName      = "/fitness_center.coach_profile.CoachProfile/GetCoachProfile"
+	CoachProfile_LinkCoachUser_FullMethodName        = "/fitness_center.coach_profile.CoachProfile/LinkCoachUser"
+	CoachProfile_UnlinkCoachUser_FullMethodName      = "/fitness_center.coach_profile.CoachProfile/UnlinkCoachUser"
+	CoachProfile_GetMyCoachProfile_FullMethodName    = "/fitness_center.coach_profile.CoachProfile/GetMyCoachProfile"
+	CoachProfile_UpdateMyCoachProfile_FullMethodName = "/fitness_center.coach_profile.CoachProfile/UpdateMyCoachProfile"
+)
+
+// CoachProfileClient is the client API for CoachProfile service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CoachProfileClient interface {
+	GetCoachProfile(ctx context.Context, in *GetCoachProfileRequest, opts ...grpc.CallOption) (*GetCoachProfileResponse, error)
+	// LinkCoachUser lets a User service account manage a coach through the
+	// My* RPCs; a user links to one coach at most
+	LinkCoachUser(ctx context.Context, in *LinkCoachUserRequest, opts ...grpc.CallOption) (*LinkCoachUserResponse, error)
+	UnlinkCoachUser(ctx context.Context, in *UnlinkCoachUserRequest, opts ...grpc.CallOption) (*UnlinkCoachUserResponse, error)
+	// GetMyCoachProfile and UpdateMyCoachProfile act on the coach linked to
+	// the authenticated user, whatever its publication status
+	GetMyCoachProfile(ctx context.Context, in *GetMyCoachProfileRequest, opts ...grpc.CallOption) (*GetMyCoachProfileResponse, error)
+	// UpdateMyCoachProfile takes the profile data first, then the chunks of an
+	// optional new photo. Coaches may only change their description and photo;
+	// their name and services are managed by admins.
+	UpdateMyCoachProfile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse], error)
+}
+
+type coachProfileClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachProfileClient(cc grpc.ClientConnInterface) CoachProfileClient {
+	return &coachProfileClient{cc}
+}
+
+func (c *coachProfileClient) GetCoachProfile(ctx context.Context, in *GetCoachProfileRequest, opts ...grpc.CallOption) (*GetCoachProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachProfileResponse)
+	err := c.cc.Invoke(ctx, CoachProfile_GetCoachProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachProfileClient) LinkCoachUser(ctx context.Context, in *LinkCoachUserRequest, opts ...grpc.CallOption) (*LinkCoachUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkCoachUserResponse)
+	err := c.cc.Invoke(ctx, CoachProfile_LinkCoachUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachProfileClient) UnlinkCoachUser(ctx context.Context, in *UnlinkCoachUserRequest, opts ...grpc.CallOption) (*UnlinkCoachUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkCoachUserResponse)
+	err := c.cc.Invoke(ctx, CoachProfile_UnlinkCoachUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachProfileClient) GetMyCoachProfile(ctx context.Context, in *GetMyCoachProfileRequest, opts ...grpc.CallOption) (*GetMyCoachProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyCoachProfileResponse)
+	err := c.cc.Invoke(ctx, CoachProfile_GetMyCoachProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachProfileClient) UpdateMyCoachProfile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CoachProfile_ServiceDesc.Streams[0], CoachProfile_UpdateMyCoachProfile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoachProfile_UpdateMyCoachProfileClient = grpc.ClientStreamingClient[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse]
+
+// CoachProfileServer is the server API for CoachProfile service.
+// All implementations must embed UnimplementedCoachProfileServer
+// for forward compatibility.
+type CoachProfileServer interface {
+	GetCoachProfile(context.Context, *GetCoachProfileRequest) (*GetCoachProfileResponse, error)
+	// LinkCoachUser lets a User service account manage a coach through the
+	// My* RPCs; a user links to one coach at most
+	LinkCoachUser(context.Context, *LinkCoachUserRequest) (*LinkCoachUserResponse, error)
+	UnlinkCoachUser(context.Context, *UnlinkCoachUserRequest) (*UnlinkCoachUserResponse, error)
+	// GetMyCoachProfile and UpdateMyCoachProfile act on the coach linked to
+	// the authenticated user, whatever its publication status
+	GetMyCoachProfile(context.Context, *GetMyCoachProfileRequest) (*GetMyCoachProfileResponse, error)
+	// UpdateMyCoachProfile takes the profile data first, then the chunks of an
+	// optional new photo. Coaches may only change their description and photo;
+	// their name and services are managed by admins.
+	UpdateMyCoachProfile(grpc.ClientStreamingServer[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse]) error
+	mustEmbedUnimplementedCoachProfileServer()
+}
+
+// UnimplementedCoachProfileServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachProfileServer struct{}
+
+func (UnimplementedCoachProfileServer) GetCoachProfile(context.Context, *GetCoachProfileRequest) (*GetCoachProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachProfile not implemented")
+}
+func (UnimplementedCoachProfileServer) LinkCoachUser(context.Context, *LinkCoachUserRequest) (*LinkCoachUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkCoachUser not implemented")
+}
+func (UnimplementedCoachProfileServer) UnlinkCoachUser(context.Context, *UnlinkCoachUserRequest) (*UnlinkCoachUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkCoachUser not implemented")
+}
+func (UnimplementedCoachProfileServer) GetMyCoachProfile(context.Context, *GetMyCoachProfileRequest) (*GetMyCoachProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyCoachProfile not implemented")
+}
+func (UnimplementedCoachProfileServer) UpdateMyCoachProfile(grpc.ClientStreamingServer[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateMyCoachProfile not implemented")
+}
+func (UnimplementedCoachProfileServer) mustEmbedUnimplementedCoachProfileServer() {}
+func (UnimplementedCoachProfileServer) testEmbeddedByValue()                      {}
+
+// UnsafeCoachProfileServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachProfileServer will
+// result in compilation errors.
+type UnsafeCoachProfileServer interface {
+	mustEmbedUnimplementedCoachProfileServer()
+}
+
+func RegisterCoachProfileServer(s grpc.ServiceRegistrar, srv CoachProfileServer) {
+	// If the following call pancis, it indicates UnimplementedCoachProfileServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachProfile_ServiceDesc, srv)
+}
+
+func _CoachProfile_GetCoachProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachProfileServer).GetCoachProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachProfile_GetCoachProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachProfileServer).GetCoachProfile(ctx, req.(*GetCoachProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachProfile_LinkCoachUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkCoachUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachProfileServer).LinkCoachUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachProfile_LinkCoachUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachProfileServer).LinkCoachUser(ctx, req.(*LinkCoachUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachProfile_UnlinkCoachUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkCoachUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachProfileServer).UnlinkCoachUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachProfile_UnlinkCoachUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachProfileServer).UnlinkCoachUser(ctx, req.(*UnlinkCoachUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachProfile_GetMyCoachProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyCoachProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachProfileServer).GetMyCoachProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachProfile_GetMyCoachProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachProfileServer).GetMyCoachProfile(ctx, req.(*GetMyCoachProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachProfile_UpdateMyCoachProfile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CoachProfileServer).UpdateMyCoachProfile(&grpc.GenericServerStream[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoachProfile_UpdateMyCoachProfileServer = grpc.ClientStreamingServer[UpdateMyCoachProfileRequest, UpdateMyCoachProfileResponse]
+
+// CoachProfile_ServiceDesc is the grpc.ServiceDesc for CoachProfile service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachProfile_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_profile.CoachProfile",
+	HandlerType: (*CoachProfileServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCoachProfile",
+			Handler:    _CoachProfile_GetCoachProfile_Handler,
+		},
+		{
+			MethodName: "LinkCoachUser",
+			Handler:    _CoachProfile_LinkCoachUser_Handler,
+		},
+		{
+			MethodName: "UnlinkCoachUser",
+			Handler:    _CoachProfile_UnlinkCoachUser_Handler,
+		},
+		{
+			MethodName: "GetMyCoachProfile",
+			Handler:    _CoachProfile_GetMyCoachProfile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UpdateMyCoachProfile",
+			Handler:       _CoachProfile_UpdateMyCoachProfile_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "coach_profile.proto",
+}
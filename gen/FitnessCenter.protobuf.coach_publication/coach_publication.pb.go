@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: coach_publication.proto
+
+package FitnessCenter_protobuf_coach_publication
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Allowed transitions: DRAFT -> IN_REVIEW, IN_REVIEW -> DRAFT,
+// IN_REVIEW -> PUBLISHED, PUBLISHED -> ARCHIVED, ARCHIVED -> DRAFT
+type CoachStatus int32
+
+const (
+	CoachStatus_COACH_STATUS_UNSPECIFIED CoachStatus = 0
+	CoachStatus_DRAFT                    CoachStatus = 1
+	CoachStatus_IN_REVIEW                CoachStatus = 2
+	CoachStatus_PUBLISHED                CoachStatus = 3
+	CoachStatus_ARCHIVED                 CoachStatus = 4
+)
+
+// Enum value maps for CoachStatus.
+var (
+	CoachStatus_name = map[int32]string{
+		0: "COACH_STATUS_UNSPECIFIED",
+		1: "DRAFT",
+		2: "IN_REVIEW",
+		3: "PUBLISHED",
+		4: "ARCHIVED",
+	}
+	CoachStatus_value = map[string]int32{
+		"COACH_STATUS_UNSPECIFIED": 0,
+		"DRAFT":                    1,
+		"IN_REVIEW":                2,
+		"PUBLISHED":                3,
+		"ARCHIVED":                 4,
+	}
+)
+
+func (x CoachStatus) Enum() *CoachStatus {
+	p := new(CoachStatus)
+	*p = x
+	return p
+}
+
+func (x CoachStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoachStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_coach_publication_proto_enumTypes[0].Descriptor()
+}
+
+func (CoachStatus) Type() protoreflect.EnumType {
+	return &file_coach_publication_proto_enumTypes[0]
+}
+
+func (x CoachStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoachStatus.Descriptor instead.
+func (CoachStatus) EnumDescriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{0}
+}
+
+type CoachPublicationObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string      `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	Name    string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status  CoachStatus `protobuf:"varint,3,opt,name=status,proto3,enum=fitness_center.coach_publication.CoachStatus" json:"status,omitempty"`
+	// RFC 3339, empty when that side of the window is open
+	PublishAt   string `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt string `protobuf:"bytes,5,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	// Whether clients see the coach now
+	Public      bool   `protobuf:"varint,6,opt,name=public,proto3" json:"public,omitempty"`
+	UpdatedTime string `protobuf:"bytes,7,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+}
+
+func (x *CoachPublicationObject) Reset() {
+	*x = CoachPublicationObject{}
+	mi := &file_coach_publication_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoachPublicationObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachPublicationObject) ProtoMessage() {}
+
+func (x *CoachPublicationObject) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachPublicationObject.ProtoReflect.Descriptor instead.
+func (*CoachPublicationObject) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CoachPublicationObject) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *CoachPublicationObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CoachPublicationObject) GetStatus() CoachStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CoachStatus_COACH_STATUS_UNSPECIFIED
+}
+
+func (x *CoachPublicationObject) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *CoachPublicationObject) GetUnpublishAt() string {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return ""
+}
+
+func (x *CoachPublicationObject) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *CoachPublicationObject) GetUpdatedTime() string {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return ""
+}
+
+type TransitionCoachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string      `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	Status  CoachStatus `protobuf:"varint,2,opt,name=status,proto3,enum=fitness_center.coach_publication.CoachStatus" json:"status,omitempty"`
+}
+
+func (x *TransitionCoachRequest) Reset() {
+	*x = TransitionCoachRequest{}
+	mi := &file_coach_publication_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionCoachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionCoachRequest) ProtoMessage() {}
+
+func (x *TransitionCoachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionCoachRequest.ProtoReflect.Descriptor instead.
+func (*TransitionCoachRequest) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransitionCoachRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *TransitionCoachRequest) GetStatus() CoachStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CoachStatus_COACH_STATUS_UNSPECIFIED
+}
+
+type TransitionCoachResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Publication *CoachPublicationObject `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
+}
+
+func (x *TransitionCoachResponse) Reset() {
+	*x = TransitionCoachResponse{}
+	mi := &file_coach_publication_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionCoachResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionCoachResponse) ProtoMessage() {}
+
+func (x *TransitionCoachResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionCoachResponse.ProtoReflect.Descriptor instead.
+func (*TransitionCoachResponse) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransitionCoachResponse) GetPublication() *CoachPublicationObject {
+	if x != nil {
+		return x.Publication
+	}
+	return nil
+}
+
+// ScheduleCoachRequest replaces the publication window of a coach, which
+// is public while published from publish_at until unpublish_at; published
+// coaches are archived once unpublish_at passes. Empty times leave that side
+// of the window open.
+type ScheduleCoachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId     string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	PublishAt   string `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt string `protobuf:"bytes,3,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+}
+
+func (x *ScheduleCoachRequest) Reset() {
+	*x = ScheduleCoachRequest{}
+	mi := &file_coach_publication_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCoachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCoachRequest) ProtoMessage() {}
+
+func (x *ScheduleCoachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCoachRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCoachRequest) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduleCoachRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *ScheduleCoachRequest) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *ScheduleCoachRequest) GetUnpublishAt() string {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return ""
+}
+
+type ScheduleCoachResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Publication *CoachPublicationObject `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
+}
+
+func (x *ScheduleCoachResponse) Reset() {
+	*x = ScheduleCoachResponse{}
+	mi := &file_coach_publication_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCoachResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCoachResponse) ProtoMessage() {}
+
+func (x *ScheduleCoachResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCoachResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleCoachResponse) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScheduleCoachResponse) GetPublication() *CoachPublicationObject {
+	if x != nil {
+		return x.Publication
+	}
+	return nil
+}
+
+type GetCoachPublicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *GetCoachPublicationRequest) Reset() {
+	*x = GetCoachPublicationRequest{}
+	mi := &file_coach_publication_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachPublicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachPublicationRequest) ProtoMessage() {}
+
+func (x *GetCoachPublicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachPublicationRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachPublicationRequest) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCoachPublicationRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type GetCoachPublicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Publication *CoachPublicationObject `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
+}
+
+func (x *GetCoachPublicationResponse) Reset() {
+	*x = GetCoachPublicationResponse{}
+	mi := &file_coach_publication_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachPublicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachPublicationResponse) ProtoMessage() {}
+
+func (x *GetCoachPublicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachPublicationResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachPublicationResponse) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCoachPublicationResponse) GetPublication() *CoachPublicationObject {
+	if x != nil {
+		return x.Publication
+	}
+	return nil
+}
+
+// An unspecified status lists coaches of every status
+type GetCoachesByStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CoachStatus `protobuf:"varint,1,opt,name=status,proto3,enum=fitness_center.coach_publication.CoachStatus" json:"status,omitempty"`
+}
+
+func (x *GetCoachesByStatusRequest) Reset() {
+	*x = GetCoachesByStatusRequest{}
+	mi := &file_coach_publication_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachesByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachesByStatusRequest) ProtoMessage() {}
+
+func (x *GetCoachesByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachesByStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachesByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCoachesByStatusRequest) GetStatus() CoachStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CoachStatus_COACH_STATUS_UNSPECIFIED
+}
+
+type GetCoachesByStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Publications []*CoachPublicationObject `protobuf:"bytes,1,rep,name=publications,proto3" json:"publications,omitempty"`
+}
+
+func (x *GetCoachesByStatusResponse) Reset() {
+	*x = GetCoachesByStatusResponse{}
+	mi := &file_coach_publication_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachesByStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachesByStatusResponse) ProtoMessage() {}
+
+func (x *GetCoachesByStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_publication_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachesByStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachesByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_coach_publication_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCoachesByStatusResponse) GetPublications() []*CoachPublicationObject {
+	if x != nil {
+		return x.Publications
+	}
+	return nil
+}
+
+var File_coach_publication_proto protoreflect.FileDescriptor
+
+var file_coach_publication_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x66, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x02, 0x0a, 0x16,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x7a, 0x0a, 0x16, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x45,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x75, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x14,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x22, 0x73, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x22,
+	0x79, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7a,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x42, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x62, 0x0a, 0x0b, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x41,
+	0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x46, 0x54,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10,
+	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x04, 0x32, 0xc5,
+	0x04, 0x0a, 0x10, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x38, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x39, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a,
+	0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x36,
+	0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x92, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x65, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x2e, 0x66, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x58, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x6e, 0x4b, 0x6f, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x2f,
+	0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x63,
+	0x6f, 0x61, 0x63, 0x68, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_publication_proto_rawDescOnce sync.Once
+	file_coach_publication_proto_rawDescData = file_coach_publication_proto_rawDesc
+)
+
+func file_coach_publication_proto_rawDescGZIP() []byte {
+	file_coach_publication_proto_rawDescOnce.Do(func() {
+		file_coach_publication_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_publication_proto_rawDescData)
+	})
+	return file_coach_publication_proto_rawDescData
+}
+
+var file_coach_publication_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_coach_publication_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_coach_publication_proto_goTypes = []any{
+	(CoachStatus)(0),                    // 0: fitness_center.coach_publication.CoachStatus
+	(*CoachPublicationObject)(nil),      // 1: fitness_center.coach_publication.CoachPublicationObject
+	(*TransitionCoachRequest)(nil),      // 2: fitness_center.coach_publication.TransitionCoachRequest
+	(*TransitionCoachResponse)(nil),     // 3: fitness_center.coach_publication.TransitionCoachResponse
+	(*ScheduleCoachRequest)(nil),        // 4: fitness_center.coach_publication.ScheduleCoachRequest
+	(*ScheduleCoachResponse)(nil),       // 5: fitness_center.coach_publication.ScheduleCoachResponse
+	(*GetCoachPublicationRequest)(nil),  // 6: fitness_center.coach_publication.GetCoachPublicationRequest
+	(*GetCoachPublicationResponse)(nil), // 7: fitness_center.coach_publication.GetCoachPublicationResponse
+	(*GetCoachesByStatusRequest)(nil),   // 8: fitness_center.coach_publication.GetCoachesByStatusRequest
+	(*GetCoachesByStatusResponse)(nil),  // 9: fitness_center.coach_publication.GetCoachesByStatusResponse
+}
+var file_coach_publication_proto_depIdxs = []int32{
+	0,  // 0: fitness_center.coach_publication.CoachPublicationObject.status:type_name -> fitness_center.coach_publication.CoachStatus
+	0,  // 1: fitness_center.coach_publication.TransitionCoachRequest.status:type_name -> fitness_center.coach_publication.CoachStatus
+	1,  // 2: fitness_center.coach_publication.TransitionCoachResponse.publication:type_name -> fitness_center.coach_publication.CoachPublicationObject
+	1,  // 3: fitness_center.coach_publication.ScheduleCoachResponse.publication:type_name -> fitness_center.coach_publication.CoachPublicationObject
+	1,  // 4: fitness_center.coach_publication.GetCoachPublicationResponse.publication:type_name -> fitness_center.coach_publication.CoachPublicationObject
+	0,  // 5: fitness_center.coach_publication.GetCoachesByStatusRequest.status:type_name -> fitness_center.coach_publication.CoachStatus
+	1,  // 6: fitness_center.coach_publication.GetCoachesByStatusResponse.publications:type_name -> fitness_center.coach_publication.CoachPublicationObject
+	2,  // 7: fitness_center.coach_publication.CoachPublication.TransitionCoach:input_type -> fitness_center.coach_publication.TransitionCoachRequest
+	4,  // 8: fitness_center.coach_publication.CoachPublication.ScheduleCoach:input_type -> fitness_center.coach_publication.ScheduleCoachRequest
+	6,  // 9: fitness_center.coach_publication.CoachPublication.GetCoachPublication:input_type -> fitness_center.coach_publication.GetCoachPublicationRequest
+	8,  // 10: fitness_center.coach_publication.CoachPublication.GetCoachesByStatus:input_type -> fitness_center.coach_publication.GetCoachesByStatusRequest
+	3,  // 11: fitness_center.coach_publication.CoachPublication.TransitionCoach:output_type -> fitness_center.coach_publication.TransitionCoachResponse
+	5,  // 12: fitness_center.coach_publication.CoachPublication.ScheduleCoach:output_type -> fitness_center.coach_publication.ScheduleCoachResponse
+	7,  // 13: fitness_center.coach_publication.CoachPublication.GetCoachPublication:output_type -> fitness_center.coach_publication.GetCoachPublicationResponse
+	9,  // 14: fitness_center.coach_publication.CoachPublication.GetCoachesByStatus:output_type -> fitness_center.coach_publication.GetCoachesByStatusResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_coach_publication_proto_init() }
+func file_coach_publication_proto_init() {
+	if File_coach_publication_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_publication_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_publication_proto_goTypes,
+		DependencyIndexes: file_coach_publication_proto_depIdxs,
+		EnumInfos:         file_coach_publication_proto_enumTypes,
+		MessageInfos:      file_coach_publication_proto_msgTypes,
+	}.Build()
+	File_coach_publication_proto = out.File
+	file_coach_publication_proto_rawDesc = nil
+	file_coach_publication_proto_goTypes = nil
+	file_coach_publication_proto_depIdxs = nil
+}
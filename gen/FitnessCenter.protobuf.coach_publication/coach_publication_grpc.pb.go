@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_publication.proto
+
+package FitnessCenter_protobuf_coach_publication
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachPublication_TransitionCoach_FullMethodName     = "/fitness_center.coach_publication.CoachPublication/TransitionCoach"
+	CoachPublication_ScheduleCoach_FullMethodName       = "/fitness_center.coach_publication.CoachPublication/ScheduleCoach"
+	CoachPublication_GetCoachPublication_FullMethodName = "/fitness_center.coach_publication.CoachPublication/GetCoachPublication"
+	CoachPublication_GetCoachesByStatus_FullMethodName  = "/fitness_center.coach_publication.CoachPublication/GetCoachesByStatus"
+)
+
+// CoachPublicationClient is the client API for CoachPublication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachPublication moves coach profiles through their publication states.
+// New coaches are drafts; the coach reads of the Coach and CoachProfile
+// services only return published coaches inside their publication window,
+// except to admins and to a coach reading their own profile.
+type CoachPublicationClient interface {
+	// TransitionCoach lets coaches submit their draft for review or withdraw
+	// it; publishing, sending back and archiving are left to admins
+	TransitionCoach(ctx context.Context, in *TransitionCoachRequest, opts ...grpc.CallOption) (*TransitionCoachResponse, error)
+	ScheduleCoach(ctx context.Context, in *ScheduleCoachRequest, opts ...grpc.CallOption) (*ScheduleCoachResponse, error)
+	GetCoachPublication(ctx context.Context, in *GetCoachPublicationRequest, opts ...grpc.CallOption) (*GetCoachPublicationResponse, error)
+	// GetCoachesByStatus lists coaches of every status to admins
+	GetCoachesByStatus(ctx context.Context, in *GetCoachesByStatusRequest, opts ...grpc.CallOption) (*GetCoachesByStatusResponse, error)
+}
+
+type coachPublicationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachPublicationClient(cc grpc.ClientConnInterface) CoachPublicationClient {
+	return &coachPublicationClient{cc}
+}
+
+func (c *coachPublicationClient) TransitionCoach(ctx context.Context, in *TransitionCoachRequest, opts ...grpc.CallOption) (*TransitionCoachResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionCoachResponse)
+	err := c.cc.Invoke(ctx, CoachPublication_TransitionCoach_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachPublicationClient) ScheduleCoach(ctx context.Context, in *ScheduleCoachRequest, opts ...grpc.CallOption) (*ScheduleCoachResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleCoachResponse)
+	err := c.cc.Invoke(ctx, CoachPublication_ScheduleCoach_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachPublicationClient) GetCoachPublication(ctx context.Context, in *GetCoachPublicationRequest, opts ...grpc.CallOption) (*GetCoachPublicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachPublicationResponse)
+	err := c.cc.Invoke(ctx, CoachPublication_GetCoachPublication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachPublicationClient) GetCoachesByStatus(ctx context.Context, in *GetCoachesByStatusRequest, opts ...grpc.CallOption) (*GetCoachesByStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachesByStatusResponse)
+	err := c.cc.Invoke(ctx, CoachPublication_GetCoachesByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachPublicationServer is the server API for CoachPublication service.
+// All implementations must embed UnimplementedCoachPublicationServer
+// for forward compatibility.
+//
+// CoachPublication moves coach profiles through their publication states.
+// New coaches are drafts; the coach reads of the Coach and CoachProfile
+// services only return published coaches inside their publication window,
+// except to admins and to a coach reading their own profile.
+type CoachPublicationServer interface {
+	// TransitionCoach lets coaches submit their draft for review or withdraw
+	// it; publishing, sending back and archiving are left to admins
+	TransitionCoach(context.Context, *TransitionCoachRequest) (*TransitionCoachResponse, error)
+	ScheduleCoach(context.Context, *ScheduleCoachRequest) (*ScheduleCoachResponse, error)
+	GetCoachPublication(context.Context, *GetCoachPublicationRequest) (*GetCoachPublicationResponse, error)
+	// GetCoachesByStatus lists coaches of every status to admins
+	GetCoachesByStatus(context.Context, *GetCoachesByStatusRequest) (*GetCoachesByStatusResponse, error)
+	mustEmbedUnimplementedCoachPublicationServer()
+}
+
+// UnimplementedCoachPublicationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachPublicationServer struct{}
+
+func (UnimplementedCoachPublicationServer) TransitionCoach(context.Context, *TransitionCoachRequest) (*TransitionCoachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionCoach not implemented")
+}
+func (UnimplementedCoachPublicationServer) ScheduleCoach(context.Context, *ScheduleCoachRequest) (*ScheduleCoachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCoach not implemented")
+}
+func (UnimplementedCoachPublicationServer) GetCoachPublication(context.Context, *GetCoachPublicationRequest) (*GetCoachPublicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachPublication not implemented")
+}
+func (UnimplementedCoachPublicationServer) GetCoachesByStatus(context.Context, *GetCoachesByStatusRequest) (*GetCoachesByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachesByStatus not implemented")
+}
+func (UnimplementedCoachPublicationServer) mustEmbedUnimplementedCoachPublicationServer() {}
+func (UnimplementedCoachPublicationServer) testEmbeddedByValue()                          {}
+
+// UnsafeCoachPublicationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachPublicationServer will
+// result in compilation errors.
+type UnsafeCoachPublicationServer interface {
+	mustEmbedUnimplementedCoachPublicationServer()
+}
+
+func RegisterCoachPublicationServer(s grpc.ServiceRegistrar, srv CoachPublicationServer) {
+	// If the following call pancis, it indicates UnimplementedCoachPublicationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachPublication_ServiceDesc, srv)
+}
+
+func _CoachPublication_TransitionCoach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionCoachRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachPublicationServer).TransitionCoach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachPublication_TransitionCoach_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachPublicationServer).TransitionCoach(ctx, req.(*TransitionCoachRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachPublication_ScheduleCoach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCoachRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachPublicationServer).ScheduleCoach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachPublication_ScheduleCoach_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachPublicationServer).ScheduleCoach(ctx, req.(*ScheduleCoachRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachPublication_GetCoachPublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachPublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachPublicationServer).GetCoachPublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachPublication_GetCoachPublication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachPublicationServer).GetCoachPublication(ctx, req.(*GetCoachPublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachPublication_GetCoachesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachPublicationServer).GetCoachesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachPublication_GetCoachesByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachPublicationServer).GetCoachesByStatus(ctx, req.(*GetCoachesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachPublication_ServiceDesc is the grpc.ServiceDesc for CoachPublication service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachPublication_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_publication.CoachPublication",
+	HandlerType: (*CoachPublicationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransitionCoach",
+			Handler:    _CoachPublication_TransitionCoach_Handler,
+		},
+		{
+			MethodName: "ScheduleCoach",
+			Handler:    _CoachPublication_ScheduleCoach_Handler,
+		},
+		{
+			MethodName: "GetCoachPublication",
+			Handler:    _CoachPublication_GetCoachPublication_Handler,
+		},
+		{
+			MethodName: "GetCoachesByStatus",
+			Handler:    _CoachPublication_GetCoachesByStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_publication.proto",
+}
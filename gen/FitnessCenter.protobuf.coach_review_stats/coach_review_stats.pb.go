@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: coach_review_stats.proto
+
+package FitnessCenter_protobuf_coach_review_stats
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// recent_review_count covers the latest trend window and
+// previous_review_count the one before it; trend is their difference.
+// refreshed_time is empty for coaches not refreshed yet.
+type ReviewStatsObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId             string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	ReviewCount         int32  `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	RecentReviewCount   int32  `protobuf:"varint,3,opt,name=recent_review_count,json=recentReviewCount,proto3" json:"recent_review_count,omitempty"`
+	PreviousReviewCount int32  `protobuf:"varint,4,opt,name=previous_review_count,json=previousReviewCount,proto3" json:"previous_review_count,omitempty"`
+	Trend               int32  `protobuf:"varint,5,opt,name=trend,proto3" json:"trend,omitempty"`
+	LastReviewTime      string `protobuf:"bytes,6,opt,name=last_review_time,json=lastReviewTime,proto3" json:"last_review_time,omitempty"`
+	RefreshedTime       string `protobuf:"bytes,7,opt,name=refreshed_time,json=refreshedTime,proto3" json:"refreshed_time,omitempty"`
+}
+
+func (x *ReviewStatsObject) Reset() {
+	*x = ReviewStatsObject{}
+	mi := &file_coach_review_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStatsObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStatsObject) ProtoMessage() {}
+
+func (x *ReviewStatsObject) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewStatsObject.ProtoReflect.Descriptor instead.
+func (*ReviewStatsObject) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewStatsObject) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *ReviewStatsObject) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ReviewStatsObject) GetRecentReviewCount() int32 {
+	if x != nil {
+		return x.RecentReviewCount
+	}
+	return 0
+}
+
+func (x *ReviewStatsObject) GetPreviousReviewCount() int32 {
+	if x != nil {
+		return x.PreviousReviewCount
+	}
+	return 0
+}
+
+func (x *ReviewStatsObject) GetTrend() int32 {
+	if x != nil {
+		return x.Trend
+	}
+	return 0
+}
+
+func (x *ReviewStatsObject) GetLastReviewTime() string {
+	if x != nil {
+		return x.LastReviewTime
+	}
+	return ""
+}
+
+func (x *ReviewStatsObject) GetRefreshedTime() string {
+	if x != nil {
+		return x.RefreshedTime
+	}
+	return ""
+}
+
+type GetCoachReviewStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *GetCoachReviewStatsRequest) Reset() {
+	*x = GetCoachReviewStatsRequest{}
+	mi := &file_coach_review_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachReviewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachReviewStatsRequest) ProtoMessage() {}
+
+func (x *GetCoachReviewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachReviewStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachReviewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCoachReviewStatsRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type GetCoachReviewStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewStats *ReviewStatsObject `protobuf:"bytes,1,opt,name=reviewStats,proto3" json:"reviewStats,omitempty"`
+}
+
+func (x *GetCoachReviewStatsResponse) Reset() {
+	*x = GetCoachReviewStatsResponse{}
+	mi := &file_coach_review_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachReviewStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachReviewStatsResponse) ProtoMessage() {}
+
+func (x *GetCoachReviewStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachReviewStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachReviewStatsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCoachReviewStatsResponse) GetReviewStats() *ReviewStatsObject {
+	if x != nil {
+		return x.ReviewStats
+	}
+	return nil
+}
+
+type GetCoachesReviewStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCoachesReviewStatsRequest) Reset() {
+	*x = GetCoachesReviewStatsRequest{}
+	mi := &file_coach_review_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachesReviewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachesReviewStatsRequest) ProtoMessage() {}
+
+func (x *GetCoachesReviewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachesReviewStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachesReviewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{3}
+}
+
+type GetCoachesReviewStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewStats []*ReviewStatsObject `protobuf:"bytes,1,rep,name=reviewStats,proto3" json:"reviewStats,omitempty"`
+}
+
+func (x *GetCoachesReviewStatsResponse) Reset() {
+	*x = GetCoachesReviewStatsResponse{}
+	mi := &file_coach_review_stats_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachesReviewStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachesReviewStatsResponse) ProtoMessage() {}
+
+func (x *GetCoachesReviewStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachesReviewStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachesReviewStatsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCoachesReviewStatsResponse) GetReviewStats() []*ReviewStatsObject {
+	if x != nil {
+		return x.ReviewStats
+	}
+	return nil
+}
+
+type RefreshCoachReviewStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoachId string `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+}
+
+func (x *RefreshCoachReviewStatsRequest) Reset() {
+	*x = RefreshCoachReviewStatsRequest{}
+	mi := &file_coach_review_stats_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCoachReviewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCoachReviewStatsRequest) ProtoMessage() {}
+
+func (x *RefreshCoachReviewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCoachReviewStatsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCoachReviewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefreshCoachReviewStatsRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type RefreshCoachReviewStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewStats *ReviewStatsObject `protobuf:"bytes,1,opt,name=reviewStats,proto3" json:"reviewStats,omitempty"`
+}
+
+func (x *RefreshCoachReviewStatsResponse) Reset() {
+	*x = RefreshCoachReviewStatsResponse{}
+	mi := &file_coach_review_stats_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCoachReviewStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCoachReviewStatsResponse) ProtoMessage() {}
+
+func (x *RefreshCoachReviewStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_review_stats_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCoachReviewStatsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshCoachReviewStatsResponse) Descriptor() ([]byte, []int) {
+	return file_coach_review_stats_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefreshCoachReviewStatsResponse) GetReviewStats() *ReviewStatsObject {
+	if x != nil {
+		return x.ReviewStats
+	}
+	return nil
+}
+
+var File_coach_review_stats_proto protoreflect.FileDescriptor
+
+var file_coach_review_stats_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x21, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x9c, 0x02,
+	0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x1e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x49, 0x64, 0x22, 0x79, 0x0a, 0x1f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63,
+	0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x32, 0xe9, 0x03,
+	0x0a, 0x10, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3d, 0x2e, 0x66, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63,
+	0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x3f, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x41, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x42, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x59, 0x5a, 0x57, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x6e, 0x4b, 0x6f, 0x2d, 0x63, 0x6f,
+	0x64, 0x65, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_review_stats_proto_rawDescOnce sync.Once
+	file_coach_review_stats_proto_rawDescData = file_coach_review_stats_proto_rawDesc
+)
+
+func file_coach_review_stats_proto_rawDescGZIP() []byte {
+	file_coach_review_stats_proto_rawDescOnce.Do(func() {
+		file_coach_review_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_review_stats_proto_rawDescData)
+	})
+	return file_coach_review_stats_proto_rawDescData
+}
+
+var file_coach_review_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_coach_review_stats_proto_goTypes = []any{
+	(*ReviewStatsObject)(nil),               // 0: fitness_center.coach_review_stats.ReviewStatsObject
+	(*GetCoachReviewStatsRequest)(nil),      // 1: fitness_center.coach_review_stats.GetCoachReviewStatsRequest
+	(*GetCoachReviewStatsResponse)(nil),     // 2: fitness_center.coach_review_stats.GetCoachReviewStatsResponse
+	(*GetCoachesReviewStatsRequest)(nil),    // 3: fitness_center.coach_review_stats.GetCoachesReviewStatsRequest
+	(*GetCoachesReviewStatsResponse)(nil),   // 4: fitness_center.coach_review_stats.GetCoachesReviewStatsResponse
+	(*RefreshCoachReviewStatsRequest)(nil),  // 5: fitness_center.coach_review_stats.RefreshCoachReviewStatsRequest
+	(*RefreshCoachReviewStatsResponse)(nil), // 6: fitness_center.coach_review_stats.RefreshCoachReviewStatsResponse
+}
+var file_coach_review_stats_proto_depIdxs = []int32{
+	0, // 0: fitness_center.coach_review_stats.GetCoachReviewStatsResponse.reviewStats:type_name -> fitness_center.coach_review_stats.ReviewStatsObject
+	0, // 1: fitness_center.coach_review_stats.GetCoachesReviewStatsResponse.reviewStats:type_name -> fitness_center.coach_review_stats.ReviewStatsObject
+	0, // 2: fitness_center.coach_review_stats.RefreshCoachReviewStatsResponse.reviewStats:type_name -> fitness_center.coach_review_stats.ReviewStatsObject
+	1, // 3: fitness_center.coach_review_stats.CoachReviewStats.GetCoachReviewStats:input_type -> fitness_center.coach_review_stats.GetCoachReviewStatsRequest
+	3, // 4: fitness_center.coach_review_stats.CoachReviewStats.GetCoachesReviewStats:input_type -> fitness_center.coach_review_stats.GetCoachesReviewStatsRequest
+	5, // 5: fitness_center.coach_review_stats.CoachReviewStats.RefreshCoachReviewStats:input_type -> fitness_center.coach_review_stats.RefreshCoachReviewStatsRequest
+	2, // 6: fitness_center.coach_review_stats.CoachReviewStats.GetCoachReviewStats:output_type -> fitness_center.coach_review_stats.GetCoachReviewStatsResponse
+	4, // 7: fitness_center.coach_review_stats.CoachReviewStats.GetCoachesReviewStats:output_type -> fitness_center.coach_review_stats.GetCoachesReviewStatsResponse
+	6, // 8: fitness_center.coach_review_stats.CoachReviewStats.RefreshCoachReviewStats:output_type -> fitness_center.coach_review_stats.RefreshCoachReviewStatsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_coach_review_stats_proto_init() }
+func file_coach_review_stats_proto_init() {
+	if File_coach_review_stats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_review_stats_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_review_stats_proto_goTypes,
+		DependencyIndexes: file_coach_review_stats_proto_depIdxs,
+		MessageInfos:      file_coach_review_stats_proto_msgTypes,
+	}.Build()
+	File_coach_review_stats_proto = out.File
+	file_coach_review_stats_proto_rawDesc = nil
+	file_coach_review_stats_proto_goTypes = nil
+	file_coach_review_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_review_stats.proto
+
+package FitnessCenter_protobuf_coach_review_stats
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachReviewStats_GetCoachReviewStats_FullMethodName     = "/fitness_center.coach_review_stats.CoachReviewStats/GetCoachReviewStats"
+	CoachReviewStats_GetCoachesReviewStats_FullMethodName   = "/fitness_center.coach_review_stats.CoachReviewStats/GetCoachesReviewStats"
+	CoachReviewStats_RefreshCoachReviewStats_FullMethodName = "/fitness_center.coach_review_stats.CoachReviewStats/RefreshCoachReviewStats"
+)
+
+// CoachReviewStatsClient is the client API for CoachReviewStats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachReviewStats summarizes the reviews of every coach, refreshed from the
+// Review service on a schedule. Reviews carry no rating, so the stats count
+// reviews instead of averaging scores. GetCoachProfile of the CoachProfile
+// service includes them too, and listings sort by them through the
+// x-sort-by metadata: review_count or review_trend.
+type CoachReviewStatsClient interface {
+	GetCoachReviewStats(ctx context.Context, in *GetCoachReviewStatsRequest, opts ...grpc.CallOption) (*GetCoachReviewStatsResponse, error)
+	// GetCoachesReviewStats returns the stats of the coaches GetCoaches lists,
+	// in the same order and for the same x-location-id and x-sort-by metadata
+	GetCoachesReviewStats(ctx context.Context, in *GetCoachesReviewStatsRequest, opts ...grpc.CallOption) (*GetCoachesReviewStatsResponse, error)
+	// RefreshCoachReviewStats recomputes the stats of a coach at once, e.g.
+	// after the Review service changed its reviews
+	RefreshCoachReviewStats(ctx context.Context, in *RefreshCoachReviewStatsRequest, opts ...grpc.CallOption) (*RefreshCoachReviewStatsResponse, error)
+}
+
+type coachReviewStatsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachReviewStatsClient(cc grpc.ClientConnInterface) CoachReviewStatsClient {
+	return &coachReviewStatsClient{cc}
+}
+
+func (c *coachReviewStatsClient) GetCoachReviewStats(ctx context.Context, in *GetCoachReviewStatsRequest, opts ...grpc.CallOption) (*GetCoachReviewStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachReviewStatsResponse)
+	err := c.cc.Invoke(ctx, CoachReviewStats_GetCoachReviewStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachReviewStatsClient) GetCoachesReviewStats(ctx context.Context, in *GetCoachesReviewStatsRequest, opts ...grpc.CallOption) (*GetCoachesReviewStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachesReviewStatsResponse)
+	err := c.cc.Invoke(ctx, CoachReviewStats_GetCoachesReviewStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachReviewStatsClient) RefreshCoachReviewStats(ctx context.Context, in *RefreshCoachReviewStatsRequest, opts ...grpc.CallOption) (*RefreshCoachReviewStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshCoachReviewStatsResponse)
+	err := c.cc.Invoke(ctx, CoachReviewStats_RefreshCoachReviewStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachReviewStatsServer is the server API for CoachReviewStats service.
+// All implementations must embed UnimplementedCoachReviewStatsServer
+// for forward compatibility.
+//
+// CoachReviewStats summarizes the reviews of every coach, refreshed from the
+// Review service on a schedule. Reviews carry no rating, so the stats count
+// reviews instead of averaging scores. GetCoachProfile of the CoachProfile
+// service includes them too, and listings sort by them through the
+// x-sort-by metadata: review_count or review_trend.
+type CoachReviewStatsServer interface {
+	GetCoachReviewStats(context.Context, *GetCoachReviewStatsRequest) (*GetCoachReviewStatsResponse, error)
+	// GetCoachesReviewStats returns the stats of the coaches GetCoaches lists,
+	// in the same order and for the same x-location-id and x-sort-by metadata
+	GetCoachesReviewStats(context.Context, *GetCoachesReviewStatsRequest) (*GetCoachesReviewStatsResponse, error)
+	// RefreshCoachReviewStats recomputes the stats of a coach at once, e.g.
+	// after the Review service changed its reviews
+	RefreshCoachReviewStats(context.Context, *RefreshCoachReviewStatsRequest) (*RefreshCoachReviewStatsResponse, error)
+	mustEmbedUnimplementedCoachReviewStatsServer()
+}
+
+// UnimplementedCoachReviewStatsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachReviewStatsServer struct{}
+
+func (UnimplementedCoachReviewStatsServer) GetCoachReviewStats(context.Context, *GetCoachReviewStatsRequest) (*GetCoachReviewStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachReviewStats not implemented")
+}
+func (UnimplementedCoachReviewStatsServer) GetCoachesReviewStats(context.Context, *GetCoachesReviewStatsRequest) (*GetCoachesReviewStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachesReviewStats not implemented")
+}
+func (UnimplementedCoachReviewStatsServer) RefreshCoachReviewStats(context.Context, *RefreshCoachReviewStatsRequest) (*RefreshCoachReviewStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCoachReviewStats not implemented")
+}
+func (UnimplementedCoachReviewStatsServer) mustEmbedUnimplementedCoachReviewStatsServer() {}
+func (UnimplementedCoachReviewStatsServer) testEmbeddedByValue()                          {}
+
+// UnsafeCoachReviewStatsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachReviewStatsServer will
+// result in compilation errors.
+type UnsafeCoachReviewStatsServer interface {
+	mustEmbedUnimplementedCoachReviewStatsServer()
+}
+
+func RegisterCoachReviewStatsServer(s grpc.ServiceRegistrar, srv CoachReviewStatsServer) {
+	// If the following call pancis, it indicates UnimplementedCoachReviewStatsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachReviewStats_ServiceDesc, srv)
+}
+
+func _CoachReviewStats_GetCoachReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachReviewStatsServer).GetCoachReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachReviewStats_GetCoachReviewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachReviewStatsServer).GetCoachReviewStats(ctx, req.(*GetCoachReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachReviewStats_GetCoachesReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachesReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachReviewStatsServer).GetCoachesReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachReviewStats_GetCoachesReviewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachReviewStatsServer).GetCoachesReviewStats(ctx, req.(*GetCoachesReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachReviewStats_RefreshCoachReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCoachReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachReviewStatsServer).RefreshCoachReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachReviewStats_RefreshCoachReviewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachReviewStatsServer).RefreshCoachReviewStats(ctx, req.(*RefreshCoachReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachReviewStats_ServiceDesc is the grpc.ServiceDesc for CoachReviewStats service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachReviewStats_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_review_stats.CoachReviewStats",
+	HandlerType: (*CoachReviewStatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCoachReviewStats",
+			Handler:    _CoachReviewStats_GetCoachReviewStats_Handler,
+		},
+		{
+			MethodName: "GetCoachesReviewStats",
+			Handler:    _CoachReviewStats_GetCoachesReviewStats_Handler,
+		},
+		{
+			MethodName: "RefreshCoachReviewStats",
+			Handler:    _CoachReviewStats_RefreshCoachReviewStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_review_stats.proto",
+}
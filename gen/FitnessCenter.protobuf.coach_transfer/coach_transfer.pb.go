@@ -0,0 +1,782 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: coach_transfer.proto
+
+package FitnessCenter_protobuf_coach_transfer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportRowResult_Action int32
+
+const (
+	ImportRowResult_ACTION_UNSPECIFIED ImportRowResult_Action = 0
+	ImportRowResult_CREATED            ImportRowResult_Action = 1
+	ImportRowResult_UPDATED            ImportRowResult_Action = 2
+	ImportRowResult_FAILED             ImportRowResult_Action = 3
+)
+
+// Enum value maps for ImportRowResult_Action.
+var (
+	ImportRowResult_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "FAILED",
+	}
+	ImportRowResult_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"CREATED":            1,
+		"UPDATED":            2,
+		"FAILED":             3,
+	}
+)
+
+func (x ImportRowResult_Action) Enum() *ImportRowResult_Action {
+	p := new(ImportRowResult_Action)
+	*p = x
+	return p
+}
+
+func (x ImportRowResult_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowResult_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_coach_transfer_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportRowResult_Action) Type() protoreflect.EnumType {
+	return &file_coach_transfer_proto_enumTypes[0]
+}
+
+func (x ImportRowResult_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowResult_Action.Descriptor instead.
+func (ImportRowResult_Action) EnumDescriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type CoachRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is only set on export
+	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExternalId      string   `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name            string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CoachServiceIds []string `protobuf:"bytes,5,rep,name=coach_service_ids,json=coachServiceIds,proto3" json:"coach_service_ids,omitempty"`
+	// photo_url is an http(s) URL on a public address the server downloads
+	// the photo from on import; photo chunks sent after the record take
+	// precedence over it.
+	// Exports set it to the stored photo.
+	PhotoUrl string `protobuf:"bytes,6,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+}
+
+func (x *CoachRecord) Reset() {
+	*x = CoachRecord{}
+	mi := &file_coach_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoachRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachRecord) ProtoMessage() {}
+
+func (x *CoachRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachRecord.ProtoReflect.Descriptor instead.
+func (*CoachRecord) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CoachRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoachRecord) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *CoachRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CoachRecord) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CoachRecord) GetCoachServiceIds() []string {
+	if x != nil {
+		return x.CoachServiceIds
+	}
+	return nil
+}
+
+func (x *CoachRecord) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type ImportOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dry_run validates every record and reports what would happen without
+	// writing anything
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_coach_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// The first message must carry the options. Every following one is a record
+// or a chunk of the photo of the record before it.
+type ImportCoachesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*ImportCoachesRequest_Options
+	//	*ImportCoachesRequest_Record
+	//	*ImportCoachesRequest_PhotoChunk
+	Payload isImportCoachesRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *ImportCoachesRequest) Reset() {
+	*x = ImportCoachesRequest{}
+	mi := &file_coach_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCoachesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCoachesRequest) ProtoMessage() {}
+
+func (x *ImportCoachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCoachesRequest.ProtoReflect.Descriptor instead.
+func (*ImportCoachesRequest) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *ImportCoachesRequest) GetPayload() isImportCoachesRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *ImportCoachesRequest) GetOptions() *ImportOptions {
+	if x, ok := x.GetPayload().(*ImportCoachesRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ImportCoachesRequest) GetRecord() *CoachRecord {
+	if x, ok := x.GetPayload().(*ImportCoachesRequest_Record); ok {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ImportCoachesRequest) GetPhotoChunk() []byte {
+	if x, ok := x.GetPayload().(*ImportCoachesRequest_PhotoChunk); ok {
+		return x.PhotoChunk
+	}
+	return nil
+}
+
+type isImportCoachesRequest_Payload interface {
+	isImportCoachesRequest_Payload()
+}
+
+type ImportCoachesRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportCoachesRequest_Record struct {
+	Record *CoachRecord `protobuf:"bytes,2,opt,name=record,proto3,oneof"`
+}
+
+type ImportCoachesRequest_PhotoChunk struct {
+	PhotoChunk []byte `protobuf:"bytes,3,opt,name=photo_chunk,json=photoChunk,proto3,oneof"`
+}
+
+func (*ImportCoachesRequest_Options) isImportCoachesRequest_Payload() {}
+
+func (*ImportCoachesRequest_Record) isImportCoachesRequest_Payload() {}
+
+func (*ImportCoachesRequest_PhotoChunk) isImportCoachesRequest_Payload() {}
+
+type FieldViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
+	mi := &file_coach_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldViolation) ProtoMessage() {}
+
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldViolation.ProtoReflect.Descriptor instead.
+func (*FieldViolation) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FieldViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// row is the 1-based position of the record in the request stream
+	Row             int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	ExternalId      string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	CoachId         string                 `protobuf:"bytes,3,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	Action          ImportRowResult_Action `protobuf:"varint,4,opt,name=action,proto3,enum=fitness_center.coach_transfer.ImportRowResult_Action" json:"action,omitempty"`
+	Error           string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	FieldViolations []*FieldViolation      `protobuf:"bytes,6,rep,name=field_violations,json=fieldViolations,proto3" json:"field_violations,omitempty"`
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_coach_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetAction() ImportRowResult_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ImportRowResult_ACTION_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetFieldViolations() []*FieldViolation {
+	if x != nil {
+		return x.FieldViolations
+	}
+	return nil
+}
+
+type ImportCoachesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun  bool               `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created int32              `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32              `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed  int32              `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results []*ImportRowResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ImportCoachesResponse) Reset() {
+	*x = ImportCoachesResponse{}
+	mi := &file_coach_transfer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCoachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCoachesResponse) ProtoMessage() {}
+
+func (x *ImportCoachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCoachesResponse.ProtoReflect.Descriptor instead.
+func (*ImportCoachesResponse) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportCoachesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportCoachesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportCoachesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportCoachesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCoachesResponse) GetResults() []*ImportRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportCoachesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportCoachesRequest) Reset() {
+	*x = ExportCoachesRequest{}
+	mi := &file_coach_transfer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCoachesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCoachesRequest) ProtoMessage() {}
+
+func (x *ExportCoachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCoachesRequest.ProtoReflect.Descriptor instead.
+func (*ExportCoachesRequest) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{6}
+}
+
+type ExportCoachesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *CoachRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *ExportCoachesResponse) Reset() {
+	*x = ExportCoachesResponse{}
+	mi := &file_coach_transfer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCoachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCoachesResponse) ProtoMessage() {}
+
+func (x *ExportCoachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_coach_transfer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCoachesResponse.ProtoReflect.Descriptor instead.
+func (*ExportCoachesResponse) Descriptor() ([]byte, []int) {
+	return file_coach_transfer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportCoachesResponse) GetRecord() *CoachRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+var File_coach_transfer_proto protoreflect.FileDescriptor
+
+var file_coach_transfer_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x28, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0xd4, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x0a, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xe6, 0x02, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x49, 0x64, 0x12, 0x4d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x58, 0x0a, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x46, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0xc6, 0x01, 0x0a, 0x15, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x32, 0x8b, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x7c, 0x0a, 0x0d, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x66, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63,
+	0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x7c, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x66, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x66, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x55, 0x5a, 0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x6e, 0x4b, 0x6f, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x46,
+	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x46, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_coach_transfer_proto_rawDescOnce sync.Once
+	file_coach_transfer_proto_rawDescData = file_coach_transfer_proto_rawDesc
+)
+
+func file_coach_transfer_proto_rawDescGZIP() []byte {
+	file_coach_transfer_proto_rawDescOnce.Do(func() {
+		file_coach_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(file_coach_transfer_proto_rawDescData)
+	})
+	return file_coach_transfer_proto_rawDescData
+}
+
+var file_coach_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_coach_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_coach_transfer_proto_goTypes = []any{
+	(ImportRowResult_Action)(0),   // 0: fitness_center.coach_transfer.ImportRowResult.Action
+	(*CoachRecord)(nil),           // 1: fitness_center.coach_transfer.CoachRecord
+	(*ImportOptions)(nil),         // 2: fitness_center.coach_transfer.ImportOptions
+	(*ImportCoachesRequest)(nil),  // 3: fitness_center.coach_transfer.ImportCoachesRequest
+	(*FieldViolation)(nil),        // 4: fitness_center.coach_transfer.FieldViolation
+	(*ImportRowResult)(nil),       // 5: fitness_center.coach_transfer.ImportRowResult
+	(*ImportCoachesResponse)(nil), // 6: fitness_center.coach_transfer.ImportCoachesResponse
+	(*ExportCoachesRequest)(nil),  // 7: fitness_center.coach_transfer.ExportCoachesRequest
+	(*ExportCoachesResponse)(nil), // 8: fitness_center.coach_transfer.ExportCoachesResponse
+}
+var file_coach_transfer_proto_depIdxs = []int32{
+	2, // 0: fitness_center.coach_transfer.ImportCoachesRequest.options:type_name -> fitness_center.coach_transfer.ImportOptions
+	1, // 1: fitness_center.coach_transfer.ImportCoachesRequest.record:type_name -> fitness_center.coach_transfer.CoachRecord
+	0, // 2: fitness_center.coach_transfer.ImportRowResult.action:type_name -> fitness_center.coach_transfer.ImportRowResult.Action
+	4, // 3: fitness_center.coach_transfer.ImportRowResult.field_violations:type_name -> fitness_center.coach_transfer.FieldViolation
+	5, // 4: fitness_center.coach_transfer.ImportCoachesResponse.results:type_name -> fitness_center.coach_transfer.ImportRowResult
+	1, // 5: fitness_center.coach_transfer.ExportCoachesResponse.record:type_name -> fitness_center.coach_transfer.CoachRecord
+	3, // 6: fitness_center.coach_transfer.CoachTransfer.ImportCoaches:input_type -> fitness_center.coach_transfer.ImportCoachesRequest
+	7, // 7: fitness_center.coach_transfer.CoachTransfer.ExportCoaches:input_type -> fitness_center.coach_transfer.ExportCoachesRequest
+	6, // 8: fitness_center.coach_transfer.CoachTransfer.ImportCoaches:output_type -> fitness_center.coach_transfer.ImportCoachesResponse
+	8, // 9: fitness_center.coach_transfer.CoachTransfer.ExportCoaches:output_type -> fitness_center.coach_transfer.ExportCoachesResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_coach_transfer_proto_init() }
+func file_coach_transfer_proto_init() {
+	if File_coach_transfer_proto != nil {
+		return
+	}
+	file_coach_transfer_proto_msgTypes[2].OneofWrappers = []any{
+		(*ImportCoachesRequest_Options)(nil),
+		(*ImportCoachesRequest_Record)(nil),
+		(*ImportCoachesRequest_PhotoChunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_coach_transfer_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_coach_transfer_proto_goTypes,
+		DependencyIndexes: file_coach_transfer_proto_depIdxs,
+		EnumInfos:         file_coach_transfer_proto_enumTypes,
+		MessageInfos:      file_coach_transfer_proto_msgTypes,
+	}.Build()
+	File_coach_transfer_proto = out.File
+	file_coach_transfer_proto_rawDesc = nil
+	file_coach_transfer_proto_goTypes = nil
+	file_coach_transfer_proto_depIdxs = nil
+}
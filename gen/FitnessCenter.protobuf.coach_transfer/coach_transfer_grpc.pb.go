@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_transfer.proto
+
+package FitnessCenter_protobuf_coach_transfer
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachTransfer_ImportCoaches_FullMethodName = "/fitness_center.coach_transfer.CoachTransfer/ImportCoaches"
+	CoachTransfer_ExportCoaches_FullMethodName = "/fitness_center.coach_transfer.CoachTransfer/ExportCoaches"
+)
+
+// CoachTransferClient is the client API for CoachTransfer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachTransfer bulk imports and exports coaches. Imports upsert by
+// external_id: a record whose external_id matches a coach updates it, any
+// other record creates a coach.
+type CoachTransferClient interface {
+	ImportCoaches(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCoachesRequest, ImportCoachesResponse], error)
+	ExportCoaches(ctx context.Context, in *ExportCoachesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCoachesResponse], error)
+}
+
+type coachTransferClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachTransferClient(cc grpc.ClientConnInterface) CoachTransferClient {
+	return &coachTransferClient{cc}
+}
+
+func (c *coachTransferClient) ImportCoaches(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCoachesRequest, ImportCoachesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CoachTransfer_ServiceDesc.Streams[0], CoachTransfer_ImportCoaches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportCoachesRequest, ImportCoachesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoachTransfer_ImportCoachesClient = grpc.ClientStreamingClient[ImportCoachesRequest, ImportCoachesResponse]
+
+func (c *coachTransferClient) ExportCoaches(ctx context.Context, in *ExportCoachesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCoachesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CoachTransfer_ServiceDesc.Streams[1], CoachTransfer_ExportCoaches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportCoachesRequest, ExportCoachesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoachTransfer_ExportCoachesClient = grpc.ServerStreamingClient[ExportCoachesResponse]
+
+// CoachTransferServer is the server API for CoachTransfer service.
+// All implementations must embed UnimplementedCoachTransferServer
+// for forward compatibility.
+//
+// CoachTransfer bulk imports and exports coaches. Imports upsert by
+// external_id: a record whose external_id matches a coach updates it, any
+// other record creates a coach.
+type CoachTransferServer interface {
+	ImportCoaches(grpc.ClientStreamingServer[ImportCoachesRequest, ImportCoachesResponse]) error
+	ExportCoaches(*ExportCoachesRequest, grpc.ServerStreamingServer[ExportCoachesResponse]) error
+	mustEmbedUnimplementedCoachTransferServer()
+}
+
+// UnimplementedCoachTransferServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachTransferServer struct{}
+
+func (UnimplementedCoachTransferServer) ImportCoaches(grpc.ClientStreamingServer[ImportCoachesRequest, ImportCoachesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCoaches not implemented")
+}
+func (UnimplementedCoachTransferServer) ExportCoaches(*ExportCoachesRequest, grpc.ServerStreamingServer[ExportCoachesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCoaches not implemented")
+}
+func (UnimplementedCoachTransferServer) mustEmbedUnimplementedCoachTransferServer() {}
+func (UnimplementedCoachTransferServer) testEmbeddedByValue()                       {}
+
+// UnsafeCoachTransferServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachTransferServer will
+// result in compilation errors.
+type UnsafeCoachTransferServer interface {
+	mustEmbedUnimplementedCoachTransferServer()
+}
+
+func RegisterCoachTransferServer(s grpc.ServiceRegistrar, srv CoachTransferServer) {
+	// If the following call pancis, it indicates UnimplementedCoachTransferServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachTransfer_ServiceDesc, srv)
+}
+
+func _CoachTransfer_ImportCoaches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CoachTransferServer).ImportCoaches(&grpc.GenericServerStream[ImportCoachesRequest, ImportCoachesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoachTransfer_ImportCoachesServer = grpc.ClientStreamingServer[ImportCoachesRequest, ImportCoachesResponse]
+
+func _CoachTransfer_ExportCoaches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCoachesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoachTransferServer).ExportCoaches(m, &grpc.GenericServerStream[ExportCoachesRequest, ExportCoachesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoachTransfer_ExportCoachesServer = grpc.ServerStreamingServer[ExportCoachesResponse]
+
+// CoachTransfer_ServiceDesc is the grpc.ServiceDesc for CoachTransfer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachTransfer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_transfer.CoachTransfer",
+	HandlerType: (*CoachTransferServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCoaches",
+			Handler:       _CoachTransfer_ImportCoaches_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCoaches",
+			Handler:       _CoachTransfer_ExportCoaches_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "coach_transfer.proto",
+}
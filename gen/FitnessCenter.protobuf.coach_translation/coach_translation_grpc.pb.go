@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: coach_translation.proto
+
+package FitnessCenter_protobuf_coach_translation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoachTranslation_SetCoachTranslation_FullMethodName    = "/fitness_center.coach_translation.CoachTranslation/SetCoachTranslation"
+	CoachTranslation_DeleteCoachTranslation_FullMethodName = "/fitness_center.coach_translation.CoachTranslation/DeleteCoachTranslation"
+	CoachTranslation_GetCoachTranslations_FullMethodName   = "/fitness_center.coach_translation.CoachTranslation/GetCoachTranslations"
+)
+
+// CoachTranslationClient is the client API for CoachTranslation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CoachTranslation manages the names and descriptions of coaches in other
+// locales than the default one. The coach reads of the Coach and
+// CoachProfile services return them for the locales sent in the
+// "accept-language" request metadata, falling back to the default locale.
+type CoachTranslationClient interface {
+	SetCoachTranslation(ctx context.Context, in *SetCoachTranslationRequest, opts ...grpc.CallOption) (*SetCoachTranslationResponse, error)
+	DeleteCoachTranslation(ctx context.Context, in *DeleteCoachTranslationRequest, opts ...grpc.CallOption) (*DeleteCoachTranslationResponse, error)
+	GetCoachTranslations(ctx context.Context, in *GetCoachTranslationsRequest, opts ...grpc.CallOption) (*GetCoachTranslationsResponse, error)
+}
+
+type coachTranslationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoachTranslationClient(cc grpc.ClientConnInterface) CoachTranslationClient {
+	return &coachTranslationClient{cc}
+}
+
+func (c *coachTranslationClient) SetCoachTranslation(ctx context.Context, in *SetCoachTranslationRequest, opts ...grpc.CallOption) (*SetCoachTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCoachTranslationResponse)
+	err := c.cc.Invoke(ctx, CoachTranslation_SetCoachTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachTranslationClient) DeleteCoachTranslation(ctx context.Context, in *DeleteCoachTranslationRequest, opts ...grpc.CallOption) (*DeleteCoachTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCoachTranslationResponse)
+	err := c.cc.Invoke(ctx, CoachTranslation_DeleteCoachTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coachTranslationClient) GetCoachTranslations(ctx context.Context, in *GetCoachTranslationsRequest, opts ...grpc.CallOption) (*GetCoachTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoachTranslationsResponse)
+	err := c.cc.Invoke(ctx, CoachTranslation_GetCoachTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoachTranslationServer is the server API for CoachTranslation service.
+// All implementations must embed UnimplementedCoachTranslationServer
+// for forward compatibility.
+//
+// CoachTranslation manages the names and descriptions of coaches in other
+// locales than the default one. The coach reads of the Coach and
+// CoachProfile services return them for the locales sent in the
+// "accept-language" request metadata, falling back to the default locale.
+type CoachTranslationServer interface {
+	SetCoachTranslation(context.Context, *SetCoachTranslationRequest) (*SetCoachTranslationResponse, error)
+	DeleteCoachTranslation(context.Context, *DeleteCoachTranslationRequest) (*DeleteCoachTranslationResponse, error)
+	GetCoachTranslations(context.Context, *GetCoachTranslationsRequest) (*GetCoachTranslationsResponse, error)
+	mustEmbedUnimplementedCoachTranslationServer()
+}
+
+// UnimplementedCoachTranslationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoachTranslationServer struct{}
+
+func (UnimplementedCoachTranslationServer) SetCoachTranslation(context.Context, *SetCoachTranslationRequest) (*SetCoachTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoachTranslation not implemented")
+}
+func (UnimplementedCoachTranslationServer) DeleteCoachTranslation(context.Context, *DeleteCoachTranslationRequest) (*DeleteCoachTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoachTranslation not implemented")
+}
+func (UnimplementedCoachTranslationServer) GetCoachTranslations(context.Context, *GetCoachTranslationsRequest) (*GetCoachTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoachTranslations not implemented")
+}
+func (UnimplementedCoachTranslationServer) mustEmbedUnimplementedCoachTranslationServer() {}
+func (UnimplementedCoachTranslationServer) testEmbeddedByValue()                          {}
+
+// UnsafeCoachTranslationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoachTranslationServer will
+// result in compilation errors.
+type UnsafeCoachTranslationServer interface {
+	mustEmbedUnimplementedCoachTranslationServer()
+}
+
+func RegisterCoachTranslationServer(s grpc.ServiceRegistrar, srv CoachTranslationServer) {
+	// If the following call pancis, it indicates UnimplementedCoachTranslationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoachTranslation_ServiceDesc, srv)
+}
+
+func _CoachTranslation_SetCoachTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoachTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachTranslationServer).SetCoachTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachTranslation_SetCoachTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachTranslationServer).SetCoachTranslation(ctx, req.(*SetCoachTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachTranslation_DeleteCoachTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCoachTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachTranslationServer).DeleteCoachTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachTranslation_DeleteCoachTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachTranslationServer).DeleteCoachTranslation(ctx, req.(*DeleteCoachTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoachTranslation_GetCoachTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoachTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoachTranslationServer).GetCoachTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoachTranslation_GetCoachTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoachTranslationServer).GetCoachTranslations(ctx, req.(*GetCoachTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoachTranslation_ServiceDesc is the grpc.ServiceDesc for CoachTranslation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoachTranslation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fitness_center.coach_translation.CoachTranslation",
+	HandlerType: (*CoachTranslationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetCoachTranslation",
+			Handler:    _CoachTranslation_SetCoachTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteCoachTranslation",
+			Handler:    _CoachTranslation_DeleteCoachTranslation_Handler,
+		},
+		{
+			MethodName: "GetCoachTranslations",
+			Handler:    _CoachTranslation_GetCoachTranslations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coach_translation.proto",
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type cachedCoach struct {
+	coachId   string
+	expiresAt time.Time
+}
+
+// CachedCoachResolver remembers what resolve returned for a user, users
+// linked to no coach included, for ttl, so authenticated requests don't each
+// cost a database read. Links and unlinks take up to ttl to be noticed.
+func CachedCoachResolver(resolve CoachResolver, ttl time.Duration) CoachResolver {
+	var mu sync.Mutex
+	coaches := map[string]cachedCoach{}
+	swept := time.Now()
+
+	return func(ctx context.Context, userId string) (string, error) {
+		now := time.Now()
+
+		mu.Lock()
+		if now.Sub(swept) > ttl {
+			for cachedUserId, cached := range coaches {
+				if now.After(cached.expiresAt) {
+					delete(coaches, cachedUserId)
+				}
+			}
+			swept = now
+		}
+		cached, ok := coaches[userId]
+		mu.Unlock()
+
+		if ok && now.Before(cached.expiresAt) {
+			return cached.coachId, nil
+		}
+
+		coachId, err := resolve(ctx, userId)
+		if err != nil {
+			return "", err
+		}
+
+		mu.Lock()
+		coaches[userId] = cachedCoach{coachId: coachId, expiresAt: now.Add(ttl)}
+		mu.Unlock()
+
+		return coachId, nil
+	}
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCachedCoachResolver(t *testing.T) {
+	calls := 0
+	resolve := CachedCoachResolver(func(_ context.Context, userId string) (string, error) {
+		calls++
+		if userId == "user-2" {
+			return "", errors.New("database is down")
+		}
+		if userId == "user-1" {
+			return "coach-1", nil
+		}
+		return "", nil
+	}, time.Minute)
+
+	tests := []struct {
+		userId    string
+		wantCoach string
+		wantErr   bool
+		wantCalls int
+	}{
+		{userId: "user-1", wantCoach: "coach-1", wantCalls: 1},
+		{userId: "user-1", wantCoach: "coach-1", wantCalls: 1},
+		{userId: "user-3", wantCalls: 2},
+		{userId: "user-3", wantCalls: 2},
+		{userId: "user-2", wantErr: true, wantCalls: 3},
+		{userId: "user-2", wantErr: true, wantCalls: 4},
+	}
+
+	for i, test := range tests {
+		coachId, err := resolve(context.Background(), test.userId)
+		if (err != nil) != test.wantErr || coachId != test.wantCoach {
+			t.Errorf("call %d for %s = %q, %v; want %q, error %v", i, test.userId, coachId, err, test.wantCoach, test.wantErr)
+		}
+		if calls != test.wantCalls {
+			t.Errorf("call %d for %s resolved %d times in total, want %d", i, test.userId, calls, test.wantCalls)
+		}
+	}
+}
+
+func TestCachedCoachResolverExpires(t *testing.T) {
+	calls := 0
+	resolve := CachedCoachResolver(func(context.Context, string) (string, error) {
+		calls++
+		return "coach-1", nil
+	}, time.Millisecond)
+
+	_, _ = resolve(context.Background(), "user-1")
+	time.Sleep(5 * time.Millisecond)
+	_, _ = resolve(context.Background(), "user-1")
+
+	if calls != 2 {
+		t.Errorf("resolved %d times, want the expired link resolved again", calls)
+	}
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Rule int
+
+const (
+	// Admin is the zero value, so RPCs missing from a policy are admin-only
+	Admin Rule = iota
+	Public
+	Authenticated
+	// AdminOrCoach lets coaches through; the handler must still check they
+	// only touch their own profile with AuthorizeCoachEdit
+	AdminOrCoach
+)
+
+// Policy maps full RPC method names to their access rule
+type Policy map[string]Rule
+
+func (p Policy) Authorize(method string, principal *Principal) error {
+	rule := p[method]
+	if rule == Public {
+		return nil
+	}
+
+	if principal == nil {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	switch rule {
+	case Authenticated:
+		return nil
+	case AdminOrCoach:
+		if principal.IsAdmin() || principal.IsCoach() {
+			return nil
+		}
+	default:
+		if principal.IsAdmin() {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, "permission denied")
+}
+
+// AuthorizeCoachEdit allows admins to edit any coach and users to edit only
+// the coach linked to them
+func AuthorizeCoachEdit(ctx context.Context, coachId string) error {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if principal.IsAdmin() || (principal.CoachId != "" && principal.CoachId == coachId) {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "coaches can only edit their own profile")
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+var (
+	admin       = &Principal{UserId: "admin-1", Roles: []string{AdminRole}}
+	linkedCoach = &Principal{UserId: "user-1", CoachId: "coach-1"}
+	coachRole   = &Principal{UserId: "user-2", Roles: []string{CoachRole}}
+	member      = &Principal{UserId: "user-3"}
+)
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy := Policy{
+		"/coach/Public":        Public,
+		"/coach/Authenticated": Authenticated,
+		"/coach/AdminOrCoach":  AdminOrCoach,
+		"/coach/Admin":         Admin,
+	}
+
+	tests := []struct {
+		method    string
+		principal *Principal
+		want      codes.Code
+	}{
+		{"/coach/Public", nil, codes.OK},
+		{"/coach/Public", member, codes.OK},
+
+		{"/coach/Authenticated", nil, codes.Unauthenticated},
+		{"/coach/Authenticated", member, codes.OK},
+
+		{"/coach/AdminOrCoach", nil, codes.Unauthenticated},
+		{"/coach/AdminOrCoach", member, codes.PermissionDenied},
+		{"/coach/AdminOrCoach", linkedCoach, codes.OK},
+		{"/coach/AdminOrCoach", coachRole, codes.OK},
+		{"/coach/AdminOrCoach", admin, codes.OK},
+
+		{"/coach/Admin", nil, codes.Unauthenticated},
+		{"/coach/Admin", linkedCoach, codes.PermissionDenied},
+		{"/coach/Admin", admin, codes.OK},
+
+		{"/coach/Unlisted", nil, codes.Unauthenticated},
+		{"/coach/Unlisted", member, codes.PermissionDenied},
+		{"/coach/Unlisted", linkedCoach, codes.PermissionDenied},
+		{"/coach/Unlisted", admin, codes.OK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method+" "+describe(test.principal), func(t *testing.T) {
+			err := policy.Authorize(test.method, test.principal)
+			if got := status.Code(err); got != test.want {
+				t.Errorf("Authorize() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeCoachEdit(t *testing.T) {
+	tests := []struct {
+		principal *Principal
+		coachId   string
+		want      codes.Code
+	}{
+		{nil, "coach-1", codes.Unauthenticated},
+		{admin, "coach-1", codes.OK},
+		{linkedCoach, "coach-1", codes.OK},
+		{linkedCoach, "coach-2", codes.PermissionDenied},
+		{coachRole, "coach-1", codes.PermissionDenied},
+		{coachRole, "", codes.PermissionDenied},
+		{member, "", codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		t.Run(describe(test.principal)+" editing "+test.coachId, func(t *testing.T) {
+			ctx := context.Background()
+			if test.principal != nil {
+				ctx = WithPrincipal(ctx, test.principal)
+			}
+
+			if got := status.Code(AuthorizeCoachEdit(ctx, test.coachId)); got != test.want {
+				t.Errorf("AuthorizeCoachEdit() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func describe(principal *Principal) string {
+	if principal == nil {
+		return "anonymous"
+	}
+
+	return principal.UserId
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	AdminRole = "admin"
+	CoachRole = "coach"
+)
+
+// Principal is the authenticated caller of an RPC. CoachId is the coach
+// linked to the user, resolved by a CoachResolver, and empty for users linked
+// to none.
+type Principal struct {
+	UserId  string
+	Roles   []string
+	CoachId string
+}
+
+// CoachResolver returns the id of the coach linked to a user, or an empty one
+// for users linked to none
+type CoachResolver func(ctx context.Context, userId string) (string, error)
+
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(AdminRole)
+}
+
+func (p *Principal) IsCoach() bool {
+	return p.HasRole(CoachRole) || p.CoachId != ""
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns nil for anonymous callers
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type claims struct {
+	jwt.RegisteredClaims
+
+	Role  string   `json:"role"`
+	Roles []string `json:"roles"`
+}
+
+// Verifier validates signed tokens against locally configured keys
+type Verifier struct {
+	keys   KeySet
+	parser *jwt.Parser
+}
+
+func NewVerifier(keys KeySet, issuer, audience string) *Verifier {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
+	return &Verifier{keys: keys, parser: jwt.NewParser(options...)}
+}
+
+func (v *Verifier) Verify(token string) (*Principal, error) {
+	var tokenClaims claims
+
+	_, err := v.parser.ParseWithClaims(token, &tokenClaims, v.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenClaims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	roles := tokenClaims.Roles
+	if tokenClaims.Role != "" {
+		roles = append(roles, tokenClaims.Role)
+	}
+
+	return &Principal{
+		UserId: tokenClaims.Subject,
+		Roles:  roles,
+	}, nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+
+	//a single key without ID verifies every token
+	if key, ok := v.keys[""]; ok && len(v.keys) == 1 {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"github.com/golang-jwt/jwt/v5"
+	"slices"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://auth.example.com"
+	testAudience = "coach"
+)
+
+func generateKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return public, private
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub": "user-1",
+		"iss": testIssuer,
+		"aud": testAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func TestVerifierVerify(t *testing.T) {
+	public, private := generateKey(t)
+	otherPublic, otherPrivate := generateKey(t)
+
+	withClaims := func(change func(jwt.MapClaims)) jwt.MapClaims {
+		claims := validClaims()
+		change(claims)
+		return claims
+	}
+
+	tests := []struct {
+		name      string
+		keys      KeySet
+		token     func(t *testing.T) string
+		wantRoles []string
+		wantErr   bool
+	}{
+		{
+			name: "valid",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", validClaims())
+			},
+		},
+		{
+			name: "role and roles merged",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", withClaims(func(c jwt.MapClaims) {
+					c["roles"] = []string{"coach"}
+					c["role"] = "admin"
+				}))
+			},
+			wantRoles: []string{"coach", "admin"},
+		},
+		{
+			name: "alg none",
+			keys: KeySet{"": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "HS256 keyed with the public key",
+			keys: KeySet{"": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, []byte(public), "", validClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", withClaims(func(c jwt.MapClaims) {
+					c["exp"] = time.Now().Add(-time.Minute).Unix()
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "no expiry",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", withClaims(func(c jwt.MapClaims) {
+					delete(c, "exp")
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong issuer",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", withClaims(func(c jwt.MapClaims) {
+					c["iss"] = "https://evil.example.com"
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", withClaims(func(c jwt.MapClaims) {
+					c["aud"] = "billing"
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "no subject",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k1", withClaims(func(c jwt.MapClaims) {
+					delete(c, "sub")
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "signed by another key",
+			keys: KeySet{"k1": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, otherPrivate, "k1", validClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "selected by kid",
+			keys: KeySet{"k1": public, "k2": otherPublic},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, otherPrivate, "k2", validClaims())
+			},
+		},
+		{
+			name: "unknown kid",
+			keys: KeySet{"k1": public, "k2": otherPublic},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "k3", validClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "no kid with a single key without ID",
+			keys: KeySet{"": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "", validClaims())
+			},
+		},
+		{
+			name: "any kid with a single key without ID",
+			keys: KeySet{"": public},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "rotated", validClaims())
+			},
+		},
+		{
+			name: "no kid with several keys",
+			keys: KeySet{"k1": public, "k2": otherPublic},
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodEdDSA, private, "", validClaims())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier := NewVerifier(test.keys, testIssuer, testAudience)
+
+			principal, err := verifier.Verify(test.token(t))
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("Verify() = %+v, want an error", principal)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if principal.UserId != "user-1" {
+				t.Errorf("Verify() UserId = %q, want user-1", principal.UserId)
+			}
+			if !slices.Equal(principal.Roles, test.wantRoles) {
+				t.Errorf("Verify() Roles = %v, want %v", principal.Roles, test.wantRoles)
+			}
+			if principal.CoachId != "" {
+				t.Errorf("Verify() CoachId = %q, want it left to the coach resolver", principal.CoachId)
+			}
+		})
+	}
+}
+
+func TestVerifierIgnoresCoachIdClaim(t *testing.T) {
+	public, private := generateKey(t)
+	verifier := NewVerifier(KeySet{"": public}, "", "")
+
+	claims := validClaims()
+	claims["coach_id"] = "coach-1"
+
+	principal, err := verifier.Verify(sign(t, jwt.SigningMethodEdDSA, private, "", claims))
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if principal.CoachId != "" {
+		t.Errorf("Verify() CoachId = %q, want the claim ignored", principal.CoachId)
+	}
+}
@@ -0,0 +1,121 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"google.golang.org/grpc/credentials"
+	"net"
+)
+
+// ServerConfig serves the reloaded certificate and, when the reloader has a
+// CA pool, requires clients to present a certificate signed by it
+func ServerConfig(r *Reloader) (*tls.Config, error) {
+	if r.Certificate() == nil {
+		return nil, errors.New("server TLS requires a certificate and key")
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.Certificate()},
+			}
+
+			if caPool := r.CAPool(); caPool != nil {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.ClientCAs = caPool
+			}
+
+			return config, nil
+		},
+	}, nil
+}
+
+// ClientCredentials dials over TLS with ClientConfig, expecting the server
+// to be serverName or, without one, the host of the dial target
+func ClientCredentials(r *Reloader, serverName string) credentials.TransportCredentials {
+	return &clientCredentials{
+		TransportCredentials: credentials.NewTLS(ClientConfig(r, serverName)),
+		reloader:             r,
+		serverName:           serverName,
+	}
+}
+
+type clientCredentials struct {
+	credentials.TransportCredentials
+
+	reloader   *Reloader
+	serverName string
+}
+
+func (c *clientCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	serverName := c.serverName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(authority)
+		if err != nil {
+			host = authority
+		}
+		serverName = host
+	}
+
+	return credentials.NewTLS(ClientConfig(c.reloader, serverName)).ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *clientCredentials) Clone() credentials.TransportCredentials {
+	return &clientCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		reloader:             c.reloader,
+		serverName:           c.serverName,
+	}
+}
+
+// ClientConfig presents the reloaded certificate, if any, and verifies that
+// the server is serverName, a host name or an IP address, against the
+// reloaded CA pool, or the system roots without one.
+func ClientConfig(r *Reloader, serverName string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if certificate := r.Certificate(); certificate != nil {
+				return certificate, nil
+			}
+
+			return &tls.Certificate{}, nil
+		},
+		//the standard verification can't see a rotated CA pool, so it is
+		//redone in VerifyConnection against the current one
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return verifyServer(state, r.CAPool(), serverName)
+		},
+	}
+}
+
+// verifyServer checks the expected name itself: the connection state only
+// keeps the SNI value, which is empty for IP addresses
+func verifyServer(state tls.ConnectionState, roots *x509.CertPool, serverName string) error {
+	if serverName == "" {
+		return errors.New("no server name to verify the server certificate against")
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range state.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       serverName,
+	})
+
+	return err
+}
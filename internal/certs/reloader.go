@@ -0,0 +1,163 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader keeps a key pair and an optional CA pool in memory and reloads
+// them whenever one of the files changes on disk, so rotated certificates
+// are picked up without a restart.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	caPool      *x509.CertPool
+	modTimes    map[string]time.Time
+}
+
+// NewReloader loads the files once and fails if any of them is missing or
+// invalid. certFile and keyFile must be set together; caFile is optional.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("certificate and key files must be set together")
+	}
+	if certFile == "" && caFile == "" {
+		return nil, errors.New("no certificate or CA file set")
+	}
+
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Watch polls the files every interval until ctx is done
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := r.changed()
+		if err != nil {
+			logger.Error(ctx, "Failed to stat certificate files", "error", err)
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		//a half-written rotation fails here and is retried on the next tick
+		if err = r.reload(); err != nil {
+			logger.Error(ctx, "Failed to reload certificates, keeping previous ones", "error", err)
+			continue
+		}
+
+		logger.Info(ctx, "Reloaded certificates", "cert", r.certFile, "ca", r.caFile)
+	}
+}
+
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.certificate
+}
+
+func (r *Reloader) CAPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.caPool
+}
+
+func (r *Reloader) files() []string {
+	var files []string
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+func (r *Reloader) changed() (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, err
+		}
+
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *Reloader) reload() error {
+	modTimes := map[string]time.Time{}
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+
+		modTimes[file] = info.ModTime()
+	}
+
+	var certificate *tls.Certificate
+	if r.certFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("load key pair %s: %w", r.certFile, err)
+		}
+
+		certificate = &keyPair
+	}
+
+	var caPool *x509.CertPool
+	if r.caFile != "" {
+		caPEM, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return err
+		}
+
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("%s has no PEM certificates", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.certificate = certificate
+	r.caPool = caPool
+	r.modTimes = modTimes
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package config
+
+import (
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"time"
+)
+
+// Config is the whole service configuration. Load fills it from, in
+// increasing priority, the defaults below, an optional YAML file, the
+// environment (including an optional .env file) and command-line flags.
+type Config struct {
+	App         AppConfig         `yaml:"app"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
+	DB          DBConfig          `yaml:"db"`
+	Cloud       CloudConfig       `yaml:"cloud"`
+	Downstream  DownstreamConfig  `yaml:"downstream"`
+	TLS         TLSConfig         `yaml:"tls"`
+	Auth        AuthConfig        `yaml:"auth"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+	Cache       CacheConfig       `yaml:"cache"`
+	Health      HealthConfig      `yaml:"health"`
+	Import      ImportConfig      `yaml:"import"`
+	Locale      LocaleConfig      `yaml:"locale"`
+	Publication PublicationConfig `yaml:"publication"`
+	ReviewStats ReviewStatsConfig `yaml:"review_stats"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+}
+
+type AppConfig struct {
+	Port     string `yaml:"port"`
+	Protocol string `yaml:"protocol"`
+	LogLevel string `yaml:"log_level"`
+}
+
+// MetricsConfig.AdminAddr serves the runtime log level apart from the
+// metrics, on loopback unless configured otherwise, as it is unauthenticated
+type MetricsConfig struct {
+	Port      string `yaml:"port"`
+	AdminAddr string `yaml:"admin_addr"`
+}
+
+type DBConfig struct {
+	Driver   string `yaml:"driver"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"ssl_mode"`
+
+	// MigrateOnStart applies pending schema migrations before serving
+	MigrateOnStart bool `yaml:"migrate_on_start"`
+}
+
+type CloudConfig struct {
+	Endpoint string `yaml:"endpoint"`
+	Region   string `yaml:"region"`
+	Bucket   string `yaml:"bucket"`
+	Key      string `yaml:"key"`
+	Secret   string `yaml:"secret"`
+}
+
+// DownstreamConfig addresses the Service, Review and User servers.
+// MethodTimeouts and FatalDependencies keep the formats understood by
+// interceptors.ParseMethodTimeouts and models.ParseDependencyPolicy.
+type DownstreamConfig struct {
+	ServiceAddr       string              `yaml:"service_addr"`
+	ReviewAddr        string              `yaml:"review_addr"`
+	UserAddr          string              `yaml:"user_addr"`
+	Timeout           time.Duration       `yaml:"timeout"`
+	MethodTimeouts    string              `yaml:"method_timeouts"`
+	FatalDependencies string              `yaml:"fatal_dependencies"`
+	TLS               DownstreamTLSConfig `yaml:"tls"`
+}
+
+type DownstreamTLSConfig struct {
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	CAFile     string `yaml:"ca_file"`
+	ServerName string `yaml:"server_name"`
+}
+
+type TLSConfig struct {
+	CertFile     string `yaml:"cert_file"`
+	KeyFile      string `yaml:"key_file"`
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+type AuthConfig struct {
+	JWKSFile      string `yaml:"jwks_file"`
+	PublicKeyFile string `yaml:"public_key_file"`
+	Issuer        string `yaml:"issuer"`
+	Audience      string `yaml:"audience"`
+}
+
+// RateLimitConfig holds per client limits as "rate:burst"
+type RateLimitConfig struct {
+	Read               string `yaml:"read"`
+	Write              string `yaml:"write"`
+	Upload             string `yaml:"upload"`
+	MaxInFlightUploads int    `yaml:"max_in_flight_uploads"`
+}
+
+// CacheConfig.CoachesTTL of zero disables caching of the aggregated coaches
+type CacheConfig struct {
+	CoachesTTL time.Duration `yaml:"coaches_ttl"`
+}
+
+type HealthConfig struct {
+	ProbeInterval time.Duration `yaml:"probe_interval"`
+}
+
+// ImportConfig.PhotoFetchTimeout bounds downloading an imported photo_url
+type ImportConfig struct {
+	PhotoFetchTimeout time.Duration `yaml:"photo_fetch_timeout"`
+}
+
+// LocaleConfig.Default is the language of the coaches' own names and
+// descriptions; Fallbacks keeps the format understood by locale.NewResolver
+type LocaleConfig struct {
+	Default   string `yaml:"default"`
+	Fallbacks string `yaml:"fallbacks"`
+}
+
+// PublicationConfig.SweepInterval is how often published coaches past their
+// publication window are archived
+type PublicationConfig struct {
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+}
+
+// ReviewStatsConfig.RefreshInterval is how often the review stats of every
+// coach are recomputed; their trend compares the last two TrendWindows
+type ReviewStatsConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+	TrendWindow     time.Duration `yaml:"trend_window"`
+}
+
+type TracingConfig struct {
+	Exporter string `yaml:"exporter"`
+	File     string `yaml:"file"`
+}
+
+func Default() *Config {
+	return &Config{
+		App: AppConfig{
+			Protocol: "tcp",
+			LogLevel: "info",
+		},
+		Metrics: MetricsConfig{
+			Port:      ":9090",
+			AdminAddr: "127.0.0.1:9091",
+		},
+		DB: DBConfig{
+			Driver:  "postgres",
+			Port:    "5432",
+			SSLMode: "disable",
+		},
+		Downstream: DownstreamConfig{
+			Timeout: 3 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Read:               "20:40",
+			Write:              "5:10",
+			Upload:             "1:3",
+			MaxInFlightUploads: 8,
+		},
+		Health: HealthConfig{
+			ProbeInterval: 10 * time.Second,
+		},
+		Import: ImportConfig{
+			PhotoFetchTimeout: 10 * time.Second,
+		},
+		Locale: LocaleConfig{
+			Default:   "ru",
+			Fallbacks: "be:ru",
+		},
+		Publication: PublicationConfig{
+			SweepInterval: time.Minute,
+		},
+		ReviewStats: ReviewStatsConfig{
+			RefreshInterval: 15 * time.Minute,
+			TrendWindow:     30 * 24 * time.Hour,
+		},
+		Tracing: TracingConfig{
+			Exporter: "none",
+		},
+	}
+}
+
+func (c DBConfig) DSN() string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		c.Host,
+		c.Port,
+		c.User,
+		c.Password,
+		c.Name,
+		c.SSLMode,
+	)
+}
+
+func (c *Config) CloudConfig() *models.CloudConfig {
+	return &models.CloudConfig{
+		EndPoint: c.Cloud.Endpoint,
+		Region:   c.Cloud.Region,
+		Bucket:   c.Cloud.Bucket,
+		Key:      c.Cloud.Key,
+		Secret:   c.Cloud.Secret,
+	}
+}
@@ -0,0 +1,274 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const validYAML = `
+app:
+  port: ":8080"
+db:
+  host: localhost
+  user: coach
+  name: coach
+cloud:
+  endpoint: http://localhost:9000
+  region: us-east-1
+  bucket: coaches
+downstream:
+  service_addr: service:8080
+  review_addr: review:8080
+  user_addr: user:8080
+auth:
+  jwks_file: jwks.json
+`
+
+// isolate keeps the environment of the machine running the tests out of Load
+func isolate(t *testing.T) {
+	t.Helper()
+
+	t.Setenv(FileEnv, "")
+	for _, b := range Default().bindings() {
+		t.Setenv(b.env, "")
+		for _, name := range b.deprecated {
+			t.Setenv(name, "")
+		}
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		env      map[string]string
+		args     []string
+		check    func(*Config) string
+		problems []string
+		wantErr  string
+	}{
+		{
+			name: "defaults",
+			yaml: validYAML,
+			check: func(c *Config) string {
+				if c.DB.Port != "5432" || c.Downstream.Timeout != 3*time.Second || c.Metrics.AdminAddr != "127.0.0.1:9091" {
+					return "defaults not applied"
+				}
+				return ""
+			},
+		},
+		{
+			name: "environment over file",
+			yaml: validYAML,
+			env:  map[string]string{"DB_HOST": "db.internal", "DOWNSTREAM_TIMEOUT": "5s"},
+			check: func(c *Config) string {
+				if c.DB.Host != "db.internal" || c.Downstream.Timeout != 5*time.Second {
+					return "environment not applied"
+				}
+				return ""
+			},
+		},
+		{
+			name: "flags over environment",
+			yaml: validYAML,
+			env:  map[string]string{"DB_HOST": "db.internal"},
+			args: []string{"-db.host", "db.flag"},
+			check: func(c *Config) string {
+				if c.DB.Host != "db.flag" {
+					return "flag not applied"
+				}
+				return ""
+			},
+		},
+		{
+			name: "deprecated environment variable",
+			yaml: validYAML,
+			env:  map[string]string{"DB_SLLMODE": "require"},
+			check: func(c *Config) string {
+				if c.DB.SSLMode != "require" {
+					return "deprecated variable not applied"
+				}
+				return ""
+			},
+		},
+		{
+			name: "new name over deprecated one",
+			yaml: validYAML,
+			env:  map[string]string{"DB_SSLMODE": "verify-full", "DB_SLLMODE": "require"},
+			check: func(c *Config) string {
+				if c.DB.SSLMode != "verify-full" {
+					return "deprecated variable took precedence"
+				}
+				return ""
+			},
+		},
+		{
+			name:     "unparsable values reported together",
+			yaml:     validYAML,
+			env:      map[string]string{"DOWNSTREAM_TIMEOUT": "soon", "MAX_IN_FLIGHT_UPLOADS": "many"},
+			problems: []string{"downstream.timeout", "rate_limit.max_in_flight_uploads"},
+		},
+		{
+			name:     "missing required settings",
+			yaml:     "",
+			problems: []string{"app.port", "db.host", "cloud.bucket", "downstream.user_addr", "auth.jwks_file"},
+		},
+		{
+			name:    "unknown file key",
+			yaml:    validYAML + "typo: true\n",
+			wantErr: "field typo not found",
+		},
+		{
+			name:    "unknown flag",
+			yaml:    validYAML,
+			args:    []string{"-db.hots", "db"},
+			wantErr: "flag provided but not defined",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isolate(t)
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			args := append([]string{"-config", writeFile(t, test.yaml)}, test.args...)
+			cfg, err := Load(args)
+
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("Load() error = %v, want it to contain %q", err, test.wantErr)
+				}
+				return
+			}
+
+			if test.problems != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Load() error = %v, want a ValidationError", err)
+				}
+				for _, key := range test.problems {
+					if !hasProblem(validationErr, key) {
+						t.Errorf("Load() problems = %v, want one for %s", validationErr.Problems, key)
+					}
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if problem := test.check(cfg); problem != "" {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	isolate(t)
+	t.Setenv(FileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	if _, err := Load(nil); err == nil || !strings.Contains(err.Error(), "reading config file") {
+		t.Fatalf("Load() error = %v, want a missing file error", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		problem string
+	}{
+		{"valid", func(*Config) {}, ""},
+		{"unknown protocol", func(c *Config) { c.App.Protocol = "udp" }, "app.protocol"},
+		{"unknown log level", func(c *Config) { c.App.LogLevel = "loud" }, "app.log_level"},
+		{"no admin address", func(c *Config) { c.Metrics.AdminAddr = "" }, "metrics.admin_addr"},
+		{"unsupported driver", func(c *Config) { c.DB.Driver = "mysql" }, "db.driver"},
+		{"unknown ssl mode", func(c *Config) { c.DB.SSLMode = "always" }, "db.ssl_mode"},
+		{"zero downstream timeout", func(c *Config) { c.Downstream.Timeout = 0 }, "downstream.timeout"},
+		{"invalid method timeouts", func(c *Config) { c.Downstream.MethodTimeouts = "GetUsersByIds" }, "downstream.method_timeouts"},
+		{"unknown fatal dependency", func(c *Config) { c.Downstream.FatalDependencies = "service,billing" }, "downstream.fatal_dependencies"},
+		{"downstream cert without key", func(c *Config) { c.Downstream.TLS.CertFile = "client.pem" }, "downstream.tls.key_file"},
+		{"key without cert", func(c *Config) { c.TLS.KeyFile = "server.key" }, "tls.cert_file"},
+		{"client CA without cert", func(c *Config) { c.TLS.ClientCAFile = "ca.pem" }, "tls.cert_file"},
+		{"no token keys", func(c *Config) { c.Auth.JWKSFile = "" }, "auth.jwks_file"},
+		{"public key instead of JWKS", func(c *Config) { c.Auth.JWKSFile, c.Auth.PublicKeyFile = "", "key.pem" }, ""},
+		{"invalid rate limit", func(c *Config) { c.RateLimit.Write = "fast" }, "rate_limit.write"},
+		{"no uploads in flight", func(c *Config) { c.RateLimit.MaxInFlightUploads = 0 }, "rate_limit.max_in_flight_uploads"},
+		{"negative cache TTL", func(c *Config) { c.Cache.CoachesTTL = -time.Second }, "cache.coaches_ttl"},
+		{"disabled cache", func(c *Config) { c.Cache.CoachesTTL = 0 }, ""},
+		{"zero probe interval", func(c *Config) { c.Health.ProbeInterval = 0 }, "health.probe_interval"},
+		{"invalid default locale", func(c *Config) { c.Locale.Default = "not a tag" }, "locale.default"},
+		{"zero sweep interval", func(c *Config) { c.Publication.SweepInterval = 0 }, "publication.sweep_interval"},
+		{"zero stats refresh", func(c *Config) { c.ReviewStats.RefreshInterval = 0 }, "review_stats.refresh_interval"},
+		{"zero trend window", func(c *Config) { c.ReviewStats.TrendWindow = 0 }, "review_stats.trend_window"},
+		{"zero photo fetch timeout", func(c *Config) { c.Import.PhotoFetchTimeout = 0 }, "import.photo_fetch_timeout"},
+		{"unknown exporter", func(c *Config) { c.Tracing.Exporter = "jaeger" }, "tracing.exporter"},
+		{"file exporter without file", func(c *Config) { c.Tracing.Exporter, c.Tracing.File = "file", "" }, "tracing.file"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validConfig()
+			test.mutate(cfg)
+
+			report := &ValidationError{}
+			cfg.validate(report)
+
+			if test.problem == "" {
+				if len(report.Problems) > 0 {
+					t.Fatalf("validate() problems = %v, want none", report.Problems)
+				}
+				return
+			}
+
+			if len(report.Problems) != 1 || !hasProblem(report, test.problem) {
+				t.Fatalf("validate() problems = %v, want one for %s", report.Problems, test.problem)
+			}
+		})
+	}
+}
+
+func validConfig() *Config {
+	cfg := Default()
+	cfg.App.Port = ":8080"
+	cfg.DB.Host = "localhost"
+	cfg.DB.User = "coach"
+	cfg.DB.Name = "coach"
+	cfg.Cloud.Endpoint = "http://localhost:9000"
+	cfg.Cloud.Region = "us-east-1"
+	cfg.Cloud.Bucket = "coaches"
+	cfg.Downstream.ServiceAddr = "service:8080"
+	cfg.Downstream.ReviewAddr = "review:8080"
+	cfg.Downstream.UserAddr = "user:8080"
+	cfg.Auth.JWKSFile = "jwks.json"
+
+	return cfg
+}
+
+func hasProblem(report *ValidationError, key string) bool {
+	for _, problem := range report.Problems {
+		if strings.HasPrefix(problem, key+" ") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,232 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"time"
+)
+
+// FileEnv names the YAML config file when the -config flag is not given
+const FileEnv = "CONFIG_FILE"
+
+// binding ties a setting to its environment variable and flag; the flag is
+// named after the setting's YAML path, e.g. -db.host. Deprecated lists old
+// environment variable names still accepted as a fallback.
+type binding struct {
+	key        string
+	env        string
+	deprecated []string
+	target     any
+	usage      string
+}
+
+func (c *Config) bindings() []binding {
+	return []binding{
+		{key: "app.port", env: "APP_PORT", target: &c.App.Port, usage: "gRPC listen address"},
+		{key: "app.protocol", env: "APP_GRPC_PROTOCOL", target: &c.App.Protocol, usage: "gRPC listen network"},
+		{key: "app.log_level", env: "LOG_LEVEL", target: &c.App.LogLevel, usage: "minimum log level"},
+		{key: "metrics.port", env: "METRICS_PORT", target: &c.Metrics.Port, usage: "metrics HTTP listen address"},
+		{key: "metrics.admin_addr", env: "METRICS_ADMIN_ADDR", target: &c.Metrics.AdminAddr, usage: "log level HTTP listen address, keep it on loopback"},
+
+		{key: "db.driver", env: "DB_DRIVER", target: &c.DB.Driver, usage: "database driver"},
+		{key: "db.host", env: "DB_HOST", target: &c.DB.Host, usage: "database host"},
+		{key: "db.port", env: "DB_PORT", target: &c.DB.Port, usage: "database port"},
+		{key: "db.user", env: "DB_USER", target: &c.DB.User, usage: "database user"},
+		{key: "db.password", env: "DB_PASSWORD", target: &c.DB.Password, usage: "database password"},
+		{key: "db.name", env: "DB_NAME", target: &c.DB.Name, usage: "database name"},
+		{key: "db.ssl_mode", env: "DB_SSLMODE", deprecated: []string{"DB_SLLMODE"}, target: &c.DB.SSLMode, usage: "database sslmode"},
+		{key: "db.migrate_on_start", env: "DB_MIGRATE_ON_START", target: &c.DB.MigrateOnStart, usage: "apply pending migrations before serving"},
+
+		{key: "cloud.endpoint", env: "AWS_ENDPOINT", target: &c.Cloud.Endpoint, usage: "S3 endpoint"},
+		{key: "cloud.region", env: "AWS_REGION", target: &c.Cloud.Region, usage: "S3 region"},
+		{key: "cloud.bucket", env: "AWS_S3_BUCKET", target: &c.Cloud.Bucket, usage: "S3 bucket for coach photos"},
+		{key: "cloud.key", env: "AWS_KEY", target: &c.Cloud.Key, usage: "S3 access key"},
+		{key: "cloud.secret", env: "AWS_SECRET", target: &c.Cloud.Secret, usage: "S3 secret key"},
+
+		{key: "downstream.service_addr", env: "SERVICE_SERVICE_PORT", target: &c.Downstream.ServiceAddr, usage: "Service server address"},
+		{key: "downstream.review_addr", env: "REVIEW_SERVICE_PORT", target: &c.Downstream.ReviewAddr, usage: "Review server address"},
+		{key: "downstream.user_addr", env: "USER_SERVICE_PORT", target: &c.Downstream.UserAddr, usage: "User server address"},
+		{key: "downstream.timeout", env: "DOWNSTREAM_TIMEOUT", target: &c.Downstream.Timeout, usage: "default downstream call timeout"},
+		{key: "downstream.method_timeouts", env: "DOWNSTREAM_METHOD_TIMEOUTS", target: &c.Downstream.MethodTimeouts, usage: "per method timeouts, e.g. GetUsersByIds=1s"},
+		{key: "downstream.fatal_dependencies", env: "FATAL_DEPENDENCIES", target: &c.Downstream.FatalDependencies, usage: "dependencies whose failure fails aggregated requests"},
+		{key: "downstream.tls.cert_file", env: "DOWNSTREAM_TLS_CERT_FILE", target: &c.Downstream.TLS.CertFile, usage: "client certificate for downstream mTLS"},
+		{key: "downstream.tls.key_file", env: "DOWNSTREAM_TLS_KEY_FILE", target: &c.Downstream.TLS.KeyFile, usage: "client key for downstream mTLS"},
+		{key: "downstream.tls.ca_file", env: "DOWNSTREAM_TLS_CA_FILE", target: &c.Downstream.TLS.CAFile, usage: "CA verifying downstream servers"},
+		{key: "downstream.tls.server_name", env: "DOWNSTREAM_TLS_SERVER_NAME", target: &c.Downstream.TLS.ServerName, usage: "expected downstream server name, the host of each downstream address by default"},
+
+		{key: "tls.cert_file", env: "TLS_CERT_FILE", target: &c.TLS.CertFile, usage: "server certificate"},
+		{key: "tls.key_file", env: "TLS_KEY_FILE", target: &c.TLS.KeyFile, usage: "server key"},
+		{key: "tls.client_ca_file", env: "TLS_CLIENT_CA_FILE", target: &c.TLS.ClientCAFile, usage: "CA verifying client certificates"},
+
+		{key: "auth.jwks_file", env: "AUTH_JWKS_FILE", target: &c.Auth.JWKSFile, usage: "JWKS with the token signing keys"},
+		{key: "auth.public_key_file", env: "AUTH_PUBLIC_KEY_FILE", target: &c.Auth.PublicKeyFile, usage: "PEM token signing key"},
+		{key: "auth.issuer", env: "AUTH_ISSUER", target: &c.Auth.Issuer, usage: "expected token issuer"},
+		{key: "auth.audience", env: "AUTH_AUDIENCE", target: &c.Auth.Audience, usage: "expected token audience"},
+
+		{key: "rate_limit.read", env: "RATE_LIMIT_READ", target: &c.RateLimit.Read, usage: "per client read limit as rate:burst"},
+		{key: "rate_limit.write", env: "RATE_LIMIT_WRITE", target: &c.RateLimit.Write, usage: "per client write limit as rate:burst"},
+		{key: "rate_limit.upload", env: "RATE_LIMIT_UPLOAD", target: &c.RateLimit.Upload, usage: "per client upload limit as rate:burst"},
+		{key: "rate_limit.max_in_flight_uploads", env: "MAX_IN_FLIGHT_UPLOADS", target: &c.RateLimit.MaxInFlightUploads, usage: "concurrent uploads across all clients"},
+
+		{key: "cache.coaches_ttl", env: "COACHES_CACHE_TTL", target: &c.Cache.CoachesTTL, usage: "aggregated coaches cache TTL, 0 disables it"},
+		{key: "health.probe_interval", env: "HEALTH_PROBE_INTERVAL", target: &c.Health.ProbeInterval, usage: "dependency probe interval"},
+		{key: "locale.default", env: "LOCALE_DEFAULT", target: &c.Locale.Default, usage: "language of the coaches' own names and descriptions"},
+		{key: "locale.fallbacks", env: "LOCALE_FALLBACKS", target: &c.Locale.Fallbacks, usage: "languages tried after a missing translation, e.g. be:ru,uk:ru en"},
+		{key: "publication.sweep_interval", env: "PUBLICATION_SWEEP_INTERVAL", target: &c.Publication.SweepInterval, usage: "interval archiving coaches past their publication window"},
+		{key: "review_stats.refresh_interval", env: "REVIEW_STATS_REFRESH_INTERVAL", target: &c.ReviewStats.RefreshInterval, usage: "interval recomputing the review stats of every coach"},
+		{key: "review_stats.trend_window", env: "REVIEW_STATS_TREND_WINDOW", target: &c.ReviewStats.TrendWindow, usage: "window of recent reviews compared with the previous one"},
+		{key: "import.photo_fetch_timeout", env: "IMPORT_PHOTO_FETCH_TIMEOUT", target: &c.Import.PhotoFetchTimeout, usage: "timeout downloading an imported photo URL"},
+
+		{key: "tracing.exporter", env: "OTEL_TRACES_EXPORTER", target: &c.Tracing.Exporter, usage: "otlp, stdout, file or none"},
+		{key: "tracing.file", env: "OTEL_TRACES_FILE", target: &c.Tracing.File, usage: "span file of the file exporter"},
+	}
+}
+
+// Load reads the configuration for the given command-line arguments and
+// validates it, reporting every invalid setting at once. A missing .env file
+// is not an error, a missing YAML file named by -config or $CONFIG_FILE is.
+func Load(args []string) (*Config, error) {
+	return load(args, (*Config).validate)
+}
+
+// LoadDatabase is Load for commands that only talk to the database, such as
+// migrate; only the database settings are validated
+func LoadDatabase(args []string) (*Config, error) {
+	return load(args, (*Config).validateDatabase)
+}
+
+func load(args []string, validate func(*Config, *ValidationError)) (*Config, error) {
+	ctx := context.Background()
+	cfg := Default()
+	bindings := cfg.bindings()
+
+	flags := flag.NewFlagSet("coach", flag.ContinueOnError)
+	configFile := flags.String("config", "", "YAML config file, defaults to $"+FileEnv)
+	flagValues := make(map[string]*string, len(bindings))
+	bindingsByKey := make(map[string]binding, len(bindings))
+	for _, b := range bindings {
+		flagValues[b.key] = flags.String(b.key, "", b.usage+" ($"+b.env+")")
+		bindingsByKey[b.key] = b
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("loading .env: %w", err)
+	}
+
+	if *configFile == "" {
+		*configFile = os.Getenv(FileEnv)
+	}
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &ValidationError{}
+
+	for _, b := range bindings {
+		value, source, ok := lookupEnv(b)
+		if !ok {
+			continue
+		}
+
+		if source != b.env {
+			logger.Warn(ctx, "Deprecated environment variable, use the new name", "deprecated", source, "name", b.env)
+		}
+
+		if err := set(b.target, value); err != nil {
+			report.add(b, err.Error())
+		}
+	}
+
+	flags.Visit(func(f *flag.Flag) {
+		b, ok := bindingsByKey[f.Name]
+		if !ok {
+			return
+		}
+
+		if err := set(b.target, *flagValues[b.key]); err != nil {
+			report.add(b, err.Error())
+		}
+	})
+
+	validate(cfg, report)
+
+	if len(report.Problems) > 0 {
+		return nil, report
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	//unknown keys are rejected, a misspelled setting would silently keep its default
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func lookupEnv(b binding) (value, source string, ok bool) {
+	if value, ok = os.LookupEnv(b.env); ok && value != "" {
+		return value, b.env, true
+	}
+
+	for _, name := range b.deprecated {
+		if value, ok = os.LookupEnv(name); ok && value != "" {
+			return value, name, true
+		}
+	}
+
+	return "", "", false
+}
+
+func set(target any, value string) error {
+	switch target := target.(type) {
+	case *string:
+		*target = value
+	case *int:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		*target = parsed
+	case *bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+		*target = parsed
+	case *time.Duration:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration", value)
+		}
+		*target = parsed
+	default:
+		return fmt.Errorf("unsupported setting type %T", target)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/interceptors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"log/slog"
+	"strings"
+)
+
+// ValidationError lists every missing or invalid setting found by Load
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// checker reports problems with settings named by their key
+type checker struct {
+	report   *ValidationError
+	bindings map[string]binding
+}
+
+func (c *Config) checker(report *ValidationError) *checker {
+	bindings := make(map[string]binding)
+	for _, b := range c.bindings() {
+		bindings[b.key] = b
+	}
+
+	return &checker{report: report, bindings: bindings}
+}
+
+func (ch *checker) problem(key, description string) {
+	ch.report.add(ch.bindings[key], description)
+}
+
+func (ch *checker) required(key, value string) {
+	if value == "" {
+		ch.problem(key, "is required")
+	}
+}
+
+func (ch *checker) oneOf(key, value string, allowed ...string) {
+	for _, candidate := range allowed {
+		if value == candidate {
+			return
+		}
+	}
+
+	ch.problem(key, fmt.Sprintf("%q must be one of %s", value, strings.Join(allowed, ", ")))
+}
+
+// pair requires a certificate and its key to be set together
+func (ch *checker) pair(certKey, cert, keyKey, key string) {
+	if cert != "" && key == "" {
+		ch.problem(keyKey, "is required with "+certKey)
+	}
+	if key != "" && cert == "" {
+		ch.problem(certKey, "is required with "+keyKey)
+	}
+}
+
+func (e *ValidationError) add(b binding, problem string) {
+	e.Problems = append(e.Problems, fmt.Sprintf("%s (-%s, $%s): %s", b.key, b.key, b.env, problem))
+}
+
+func (c *Config) validateDatabase(report *ValidationError) {
+	ch := c.checker(report)
+
+	ch.required("db.driver", c.DB.Driver)
+	ch.required("db.host", c.DB.Host)
+	ch.required("db.port", c.DB.Port)
+	ch.required("db.user", c.DB.User)
+	ch.required("db.name", c.DB.Name)
+	ch.oneOf("db.ssl_mode", c.DB.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+
+	if c.DB.Driver != "" && c.DB.Driver != "postgres" {
+		ch.problem("db.driver", "only postgres is supported")
+	}
+}
+
+func (c *Config) validate(report *ValidationError) {
+	ch := c.checker(report)
+
+	ch.required("app.port", c.App.Port)
+	ch.oneOf("app.protocol", c.App.Protocol, "tcp", "tcp4", "tcp6", "unix")
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.App.LogLevel)); err != nil {
+		ch.problem("app.log_level", fmt.Sprintf("%q is not a log level", c.App.LogLevel))
+	}
+	ch.required("metrics.port", c.Metrics.Port)
+	ch.required("metrics.admin_addr", c.Metrics.AdminAddr)
+
+	c.validateDatabase(report)
+
+	ch.required("cloud.endpoint", c.Cloud.Endpoint)
+	ch.required("cloud.region", c.Cloud.Region)
+	ch.required("cloud.bucket", c.Cloud.Bucket)
+
+	ch.required("downstream.service_addr", c.Downstream.ServiceAddr)
+	ch.required("downstream.review_addr", c.Downstream.ReviewAddr)
+	ch.required("downstream.user_addr", c.Downstream.UserAddr)
+	if c.Downstream.Timeout <= 0 {
+		ch.problem("downstream.timeout", "must be positive")
+	}
+	if _, err := interceptors.ParseMethodTimeouts(c.Downstream.MethodTimeouts); err != nil {
+		ch.problem("downstream.method_timeouts", err.Error())
+	}
+	for _, dependency := range strings.Split(c.Downstream.FatalDependencies, ",") {
+		switch models.Dependency(strings.TrimSpace(dependency)) {
+		case "", models.ServiceDependency, models.ReviewDependency, models.UserDependency:
+		default:
+			ch.problem("downstream.fatal_dependencies", fmt.Sprintf("unknown dependency %q", dependency))
+		}
+	}
+	ch.pair("downstream.tls.cert_file", c.Downstream.TLS.CertFile, "downstream.tls.key_file", c.Downstream.TLS.KeyFile)
+
+	ch.pair("tls.cert_file", c.TLS.CertFile, "tls.key_file", c.TLS.KeyFile)
+	if c.TLS.ClientCAFile != "" && c.TLS.CertFile == "" {
+		ch.problem("tls.cert_file", "is required with tls.client_ca_file")
+	}
+
+	if c.Auth.JWKSFile == "" && c.Auth.PublicKeyFile == "" {
+		ch.problem("auth.jwks_file", "is required unless auth.public_key_file is set")
+	}
+
+	for _, limit := range []struct{ key, value string }{
+		{"rate_limit.read", c.RateLimit.Read},
+		{"rate_limit.write", c.RateLimit.Write},
+		{"rate_limit.upload", c.RateLimit.Upload},
+	} {
+		if _, err := interceptors.ParseLimit(limit.value); err != nil {
+			ch.problem(limit.key, err.Error())
+		}
+	}
+	if c.RateLimit.MaxInFlightUploads < 1 {
+		ch.problem("rate_limit.max_in_flight_uploads", "must be at least 1")
+	}
+
+	if c.Cache.CoachesTTL < 0 {
+		ch.problem("cache.coaches_ttl", "must not be negative")
+	}
+	if c.Health.ProbeInterval <= 0 {
+		ch.problem("health.probe_interval", "must be positive")
+	}
+	if !locale.Valid(locale.Normalize(c.Locale.Default)) {
+		ch.problem("locale.default", "must be a language tag, e.g. ru")
+	} else if _, err := locale.NewResolver(c.Locale.Default, c.Locale.Fallbacks); err != nil {
+		ch.problem("locale.fallbacks", err.Error())
+	}
+	if c.Publication.SweepInterval <= 0 {
+		ch.problem("publication.sweep_interval", "must be positive")
+	}
+	if c.ReviewStats.RefreshInterval <= 0 {
+		ch.problem("review_stats.refresh_interval", "must be positive")
+	}
+	if c.ReviewStats.TrendWindow <= 0 {
+		ch.problem("review_stats.trend_window", "must be positive")
+	}
+	if c.Import.PhotoFetchTimeout <= 0 {
+		ch.problem("import.photo_fetch_timeout", "must be positive")
+	}
+
+	ch.oneOf("tracing.exporter", c.Tracing.Exporter, "none", "otlp", "stdout", "file")
+	if c.Tracing.Exporter == "file" {
+		ch.required("tracing.file", c.Tracing.File)
+	}
+}
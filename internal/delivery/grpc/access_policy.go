@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	coachContactProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_contact"
+	coachFavoriteProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_favorite"
+	coachLocationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_location"
+	coachProfileProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_profile"
+	coachPublicationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_publication"
+	coachReviewStatsProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_review_stats"
+	coachTransferProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_transfer"
+	coachTranslationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_translation"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/interceptors"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// AccessPolicy makes reads public and writes admin-only, except that coaches
+// may update parts of their own profile, translations and contacts and
+// submit it for review; users linked to a coach manage it through the My*
+// RPCs, and any user manages their favorites. Unlisted RPCs are admin-only.
+func AccessPolicy() auth.Policy {
+	return auth.Policy{
+		coachProtobuf.Coach_GetCoachById_FullMethodName:                                auth.Public,
+		coachProtobuf.Coach_GetCoaches_FullMethodName:                                  auth.Public,
+		coachProtobuf.Coach_GetCoachesWithServicesWithReviewsWithUsers_FullMethodName:  auth.Public,
+		coachProfileProtobuf.CoachProfile_GetCoachProfile_FullMethodName:               auth.Public,
+		coachLocationProtobuf.CoachLocation_GetLocationById_FullMethodName:             auth.Public,
+		coachLocationProtobuf.CoachLocation_GetLocations_FullMethodName:                auth.Public,
+		coachLocationProtobuf.CoachLocation_GetCoachLocations_FullMethodName:           auth.Public,
+		coachLocationProtobuf.CoachLocation_CheckCoachLocation_FullMethodName:          auth.Public,
+		coachTranslationProtobuf.CoachTranslation_GetCoachTranslations_FullMethodName:  auth.Public,
+		coachContactProtobuf.CoachContact_GetCoachContacts_FullMethodName:              auth.Public,
+		coachReviewStatsProtobuf.CoachReviewStats_GetCoachReviewStats_FullMethodName:   auth.Public,
+		coachReviewStatsProtobuf.CoachReviewStats_GetCoachesReviewStats_FullMethodName: auth.Public,
+		coachFavoriteProtobuf.CoachFavorite_GetCoachFavoriteStats_FullMethodName:       auth.Public,
+		coachFavoriteProtobuf.CoachFavorite_GetCoachesFavoriteStats_FullMethodName:     auth.Public,
+
+		coachProtobuf.Coach_CreateCoach_FullMethodName:     auth.Admin,
+		coachProtobuf.Coach_UpdateCoach_FullMethodName:     auth.AdminOrCoach,
+		coachProtobuf.Coach_DeleteCoachById_FullMethodName: auth.Admin,
+
+		coachLocationProtobuf.CoachLocation_CreateLocation_FullMethodName:     auth.Admin,
+		coachLocationProtobuf.CoachLocation_UpdateLocation_FullMethodName:     auth.Admin,
+		coachLocationProtobuf.CoachLocation_DeleteLocationById_FullMethodName: auth.Admin,
+		coachLocationProtobuf.CoachLocation_SetCoachLocations_FullMethodName:  auth.Admin,
+
+		coachContactProtobuf.CoachContact_SetCoachContacts_FullMethodName: auth.AdminOrCoach,
+
+		coachReviewStatsProtobuf.CoachReviewStats_RefreshCoachReviewStats_FullMethodName: auth.Admin,
+
+		coachFavoriteProtobuf.CoachFavorite_AddFavoriteCoach_FullMethodName:     auth.Authenticated,
+		coachFavoriteProtobuf.CoachFavorite_RemoveFavoriteCoach_FullMethodName:  auth.Authenticated,
+		coachFavoriteProtobuf.CoachFavorite_GetMyFavoriteCoaches_FullMethodName: auth.Authenticated,
+
+		coachTranslationProtobuf.CoachTranslation_SetCoachTranslation_FullMethodName:    auth.AdminOrCoach,
+		coachTranslationProtobuf.CoachTranslation_DeleteCoachTranslation_FullMethodName: auth.AdminOrCoach,
+
+		coachProfileProtobuf.CoachProfile_GetMyCoachProfile_FullMethodName:    auth.Authenticated,
+		coachProfileProtobuf.CoachProfile_UpdateMyCoachProfile_FullMethodName: auth.Authenticated,
+		coachProfileProtobuf.CoachProfile_LinkCoachUser_FullMethodName:        auth.Admin,
+		coachProfileProtobuf.CoachProfile_UnlinkCoachUser_FullMethodName:      auth.Admin,
+
+		coachPublicationProtobuf.CoachPublication_TransitionCoach_FullMethodName:     auth.AdminOrCoach,
+		coachPublicationProtobuf.CoachPublication_GetCoachPublication_FullMethodName: auth.AdminOrCoach,
+		coachPublicationProtobuf.CoachPublication_ScheduleCoach_FullMethodName:       auth.Admin,
+		coachPublicationProtobuf.CoachPublication_GetCoachesByStatus_FullMethodName:  auth.Admin,
+
+		coachTransferProtobuf.CoachTransfer_ImportCoaches_FullMethodName: auth.Admin,
+		coachTransferProtobuf.CoachTransfer_ExportCoaches_FullMethodName: auth.Admin,
+
+		healthpb.Health_Check_FullMethodName: auth.Public,
+		healthpb.Health_Watch_FullMethodName: auth.Public,
+	}
+}
+
+// RateLimitClasses marks the photo-carrying streams, imports included, as
+// uploads and counts exports as writes for their cost; other RPCs are
+// classified as reads or writes by name
+func RateLimitClasses() map[string]interceptors.RPCClass {
+	return map[string]interceptors.RPCClass{
+		coachProtobuf.Coach_CreateCoach_FullMethodName: interceptors.UploadClass,
+		coachProtobuf.Coach_UpdateCoach_FullMethodName: interceptors.UploadClass,
+
+		coachProfileProtobuf.CoachProfile_UpdateMyCoachProfile_FullMethodName: interceptors.UploadClass,
+
+		coachTransferProtobuf.CoachTransfer_ImportCoaches_FullMethodName: interceptors.UploadClass,
+		coachTransferProtobuf.CoachTransfer_ExportCoaches_FullMethodName: interceptors.WriteClass,
+	}
+}
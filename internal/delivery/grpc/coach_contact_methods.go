@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	coachContactProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_contact"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+)
+
+var _ coachContactProtobuf.CoachContactServer = (*CoachContactgRPC)(nil)
+
+type CoachContactgRPC struct {
+	coachContactProtobuf.UnimplementedCoachContactServer
+
+	contactUseCase usecase.ContactUseCase
+}
+
+func RegisterCoachContactServer(gRPC *grpc.Server, contactUseCase usecase.ContactUseCase) {
+	coachContactProtobuf.RegisterCoachContactServer(gRPC, &CoachContactgRPC{contactUseCase: contactUseCase})
+}
+
+func (c *CoachContactgRPC) SetCoachContacts(ctx context.Context, request *coachContactProtobuf.SetCoachContactsRequest) (*coachContactProtobuf.SetCoachContactsResponse, error) {
+	coachId, contacts, err := validation.SetCoachContacts(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	if err = auth.AuthorizeCoachEdit(ctx, coachId.String()); err != nil {
+		return nil, err
+	}
+
+	contacts, err = c.contactUseCase.SetCoachContacts(ctx, coachId, contacts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachContactProtobuf.SetCoachContactsResponse{Contacts: contactObjects(contacts)}, nil
+}
+
+func (c *CoachContactgRPC) GetCoachContacts(ctx context.Context, request *coachContactProtobuf.GetCoachContactsRequest) (*coachContactProtobuf.GetCoachContactsResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	contacts, err := c.contactUseCase.GetCoachContacts(ctx, coachId, contactAudience(ctx), !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachContactProtobuf.GetCoachContactsResponse{Contacts: contactObjects(contacts)}, nil
+}
+
+// contactAudience is the widest contact visibility the caller may see:
+// staff are admins and coaches, members any other signed-in user
+func contactAudience(ctx context.Context) models.ContactVisibility {
+	principal := auth.PrincipalFromContext(ctx)
+	switch {
+	case principal == nil:
+		return models.ContactPublic
+	case principal.IsAdmin() || principal.IsCoach():
+		return models.ContactStaff
+	default:
+		return models.ContactMembers
+	}
+}
+
+func contactObjects(contacts []*models.CoachContact) []*coachContactProtobuf.CoachContactObject {
+	objects := make([]*coachContactProtobuf.CoachContactObject, 0, len(contacts))
+	for _, contact := range contacts {
+		objects = append(objects, &coachContactProtobuf.CoachContactObject{
+			Kind:       contactKindEnum(contact.Kind),
+			Value:      contact.Value,
+			Visibility: contactVisibilityEnum(contact.Visibility),
+		})
+	}
+
+	return objects
+}
+
+func contactKindEnum(kind models.ContactKind) coachContactProtobuf.ContactKind {
+	switch kind {
+	case models.ContactEmail:
+		return coachContactProtobuf.ContactKind_EMAIL
+	case models.ContactPhone:
+		return coachContactProtobuf.ContactKind_PHONE
+	case models.ContactTelegram:
+		return coachContactProtobuf.ContactKind_TELEGRAM
+	case models.ContactWhatsApp:
+		return coachContactProtobuf.ContactKind_WHATSAPP
+	case models.ContactViber:
+		return coachContactProtobuf.ContactKind_VIBER
+	case models.ContactInstagram:
+		return coachContactProtobuf.ContactKind_INSTAGRAM
+	case models.ContactFacebook:
+		return coachContactProtobuf.ContactKind_FACEBOOK
+	case models.ContactVK:
+		return coachContactProtobuf.ContactKind_VK
+	case models.ContactYouTube:
+		return coachContactProtobuf.ContactKind_YOUTUBE
+	case models.ContactWebsite:
+		return coachContactProtobuf.ContactKind_WEBSITE
+	default:
+		return coachContactProtobuf.ContactKind_CONTACT_KIND_UNSPECIFIED
+	}
+}
+
+func contactVisibilityEnum(visibility models.ContactVisibility) coachContactProtobuf.ContactVisibility {
+	switch visibility {
+	case models.ContactPublic:
+		return coachContactProtobuf.ContactVisibility_PUBLIC
+	case models.ContactMembers:
+		return coachContactProtobuf.ContactVisibility_MEMBERS
+	case models.ContactStaff:
+		return coachContactProtobuf.ContactVisibility_STAFF
+	default:
+		return coachContactProtobuf.ContactVisibility_CONTACT_VISIBILITY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	coachFavoriteProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_favorite"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+var _ coachFavoriteProtobuf.CoachFavoriteServer = (*CoachFavoritegRPC)(nil)
+
+type CoachFavoritegRPC struct {
+	coachFavoriteProtobuf.UnimplementedCoachFavoriteServer
+
+	coachUseCase    usecase.CoachUseCase
+	favoriteUseCase usecase.FavoriteUseCase
+}
+
+func RegisterCoachFavoriteServer(gRPC *grpc.Server, coachUseCase usecase.CoachUseCase, favoriteUseCase usecase.FavoriteUseCase) {
+	coachFavoriteProtobuf.RegisterCoachFavoriteServer(gRPC, &CoachFavoritegRPC{
+		coachUseCase:    coachUseCase,
+		favoriteUseCase: favoriteUseCase,
+	})
+}
+
+func (c *CoachFavoritegRPC) AddFavoriteCoach(ctx context.Context, request *coachFavoriteProtobuf.AddFavoriteCoachRequest) (*coachFavoriteProtobuf.AddFavoriteCoachResponse, error) {
+	userId, err := callerUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	stats, err := c.favoriteUseCase.AddFavoriteCoach(ctx, userId, coachId, !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachFavoriteProtobuf.AddFavoriteCoachResponse{FavoriteStats: favoriteStatsObject(stats)}, nil
+}
+
+func (c *CoachFavoritegRPC) RemoveFavoriteCoach(ctx context.Context, request *coachFavoriteProtobuf.RemoveFavoriteCoachRequest) (*coachFavoriteProtobuf.RemoveFavoriteCoachResponse, error) {
+	userId, err := callerUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	stats, err := c.favoriteUseCase.RemoveFavoriteCoach(ctx, userId, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachFavoriteProtobuf.RemoveFavoriteCoachResponse{FavoriteStats: favoriteStatsObject(stats)}, nil
+}
+
+func (c *CoachFavoritegRPC) GetMyFavoriteCoaches(ctx context.Context, _ *coachFavoriteProtobuf.GetMyFavoriteCoachesRequest) (*coachFavoriteProtobuf.GetMyFavoriteCoachesResponse, error) {
+	userId, err := callerUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//favorites of coaches no longer public are kept but not listed
+	coaches, err := c.coachUseCase.GetCoaches(ctx, dtos.CoachesFilter{
+		PublicOnly:    true,
+		UserId:        userId,
+		FavoritesOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var coachObjects []*coachProtobuf.CoachObject
+
+	for _, coach := range coaches {
+
+		coachObject := &coachProtobuf.CoachObject{
+			Id:          coach.Id.String(),
+			Name:        coach.Name,
+			Description: coach.Description,
+			Photo:       coach.Photo,
+			CreatedTime: coach.CreatedTime.String(),
+			UpdatedTime: coach.UpdatedTime.String(),
+		}
+
+		coachObjects = append(coachObjects, coachObject)
+	}
+
+	return &coachFavoriteProtobuf.GetMyFavoriteCoachesResponse{CoachObjects: coachObjects}, nil
+}
+
+func (c *CoachFavoritegRPC) GetCoachFavoriteStats(ctx context.Context, request *coachFavoriteProtobuf.GetCoachFavoriteStatsRequest) (*coachFavoriteProtobuf.GetCoachFavoriteStatsResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	stats, err := c.favoriteUseCase.GetCoachFavoriteStats(ctx, coachId, optionalCallerUserId(ctx), !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachFavoriteProtobuf.GetCoachFavoriteStatsResponse{FavoriteStats: favoriteStatsObject(stats)}, nil
+}
+
+func (c *CoachFavoritegRPC) GetCoachesFavoriteStats(ctx context.Context, _ *coachFavoriteProtobuf.GetCoachesFavoriteStatsRequest) (*coachFavoriteProtobuf.GetCoachesFavoriteStatsResponse, error) {
+	filter, err := coachesFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := c.favoriteUseCase.GetCoachesFavoriteStats(ctx, filter, optionalCallerUserId(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*coachFavoriteProtobuf.FavoriteStatsObject, 0, len(stats))
+	for _, coachStats := range stats {
+		objects = append(objects, favoriteStatsObject(coachStats))
+	}
+
+	return &coachFavoriteProtobuf.GetCoachesFavoriteStatsResponse{FavoriteStats: objects}, nil
+}
+
+// optionalCallerUserId returns uuid.Nil, which favorited no coach, for
+// anonymous callers and those whose token subject isn't a user id
+func optionalCallerUserId(ctx context.Context) uuid.UUID {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return uuid.Nil
+	}
+
+	userId, err := uuid.Parse(principal.UserId)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return userId
+}
+
+func favoriteStatsObject(stats *models.CoachFavoriteStats) *coachFavoriteProtobuf.FavoriteStatsObject {
+	return &coachFavoriteProtobuf.FavoriteStatsObject{
+		CoachId:       stats.CoachId.String(),
+		FavoriteCount: int32(stats.FavoriteCount),
+		Favorited:     stats.Favorited,
+	}
+}
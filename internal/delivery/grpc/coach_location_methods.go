@@ -0,0 +1,227 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	coachLocationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_location"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"time"
+)
+
+var _ coachLocationProtobuf.CoachLocationServer = (*CoachLocationgRPC)(nil)
+
+// LocationMetadataKey narrows the coach listings of the Coach service, whose
+// requests have no fields, to the coaches of one location
+const LocationMetadataKey = "x-location-id"
+
+// SortMetadataKey orders public coach listings, see dtos.CoachesSort
+const SortMetadataKey = "x-sort-by"
+
+type CoachLocationgRPC struct {
+	coachLocationProtobuf.UnimplementedCoachLocationServer
+
+	locationUseCase usecase.LocationUseCase
+}
+
+func RegisterCoachLocationServer(gRPC *grpc.Server, locationUseCase usecase.LocationUseCase) {
+	coachLocationProtobuf.RegisterCoachLocationServer(gRPC, &CoachLocationgRPC{locationUseCase: locationUseCase})
+}
+
+func (c *CoachLocationgRPC) CreateLocation(ctx context.Context, request *coachLocationProtobuf.CreateLocationRequest) (*coachLocationProtobuf.CreateLocationResponse, error) {
+	if err := validation.CreateLocation(request); err != nil {
+		return nil, err
+	}
+
+	location, err := c.locationUseCase.CreateLocation(ctx, &dtos.CreateLocationCommand{
+		Id:      uuid.New(),
+		Name:    request.Name,
+		Address: request.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.CreateLocationResponse{LocationObject: locationObject(location)}, nil
+}
+
+func (c *CoachLocationgRPC) GetLocationById(ctx context.Context, request *coachLocationProtobuf.GetLocationByIdRequest) (*coachLocationProtobuf.GetLocationByIdResponse, error) {
+	id, err := validation.LocationId("id", request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := c.locationUseCase.GetLocationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.GetLocationByIdResponse{LocationObject: locationObject(location)}, nil
+}
+
+func (c *CoachLocationgRPC) UpdateLocation(ctx context.Context, request *coachLocationProtobuf.UpdateLocationRequest) (*coachLocationProtobuf.UpdateLocationResponse, error) {
+	id, err := validation.UpdateLocation(request)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := c.locationUseCase.UpdateLocation(ctx, &dtos.UpdateLocationCommand{
+		Id:          id,
+		Name:        request.Name,
+		Address:     request.Address,
+		UpdatedTime: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.UpdateLocationResponse{LocationObject: locationObject(location)}, nil
+}
+
+func (c *CoachLocationgRPC) DeleteLocationById(ctx context.Context, request *coachLocationProtobuf.DeleteLocationByIdRequest) (*coachLocationProtobuf.DeleteLocationByIdResponse, error) {
+	id, err := validation.LocationId("id", request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := c.locationUseCase.DeleteLocationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.DeleteLocationByIdResponse{LocationObject: locationObject(location)}, nil
+}
+
+func (c *CoachLocationgRPC) GetLocations(ctx context.Context, _ *coachLocationProtobuf.GetLocationsRequest) (*coachLocationProtobuf.GetLocationsResponse, error) {
+	locations, err := c.locationUseCase.GetLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &coachLocationProtobuf.GetLocationsResponse{}
+	for _, location := range locations {
+		response.LocationObjects = append(response.LocationObjects, locationObject(location))
+	}
+
+	return response, nil
+}
+
+func (c *CoachLocationgRPC) SetCoachLocations(ctx context.Context, request *coachLocationProtobuf.SetCoachLocationsRequest) (*coachLocationProtobuf.SetCoachLocationsResponse, error) {
+	coachId, locationIds, primaryLocationId, err := validation.SetCoachLocations(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	coachLocations, err := c.locationUseCase.SetCoachLocations(ctx, &dtos.SetCoachLocationsCommand{
+		CoachId:           coachId,
+		LocationIds:       locationIds,
+		PrimaryLocationId: primaryLocationId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.SetCoachLocationsResponse{CoachLocations: coachLocationObjects(coachLocations)}, nil
+}
+
+func (c *CoachLocationgRPC) GetCoachLocations(ctx context.Context, request *coachLocationProtobuf.GetCoachLocationsRequest) (*coachLocationProtobuf.GetCoachLocationsResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	coachLocations, err := c.locationUseCase.GetCoachLocations(ctx, coachId, !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.GetCoachLocationsResponse{CoachLocations: coachLocationObjects(coachLocations)}, nil
+}
+
+func (c *CoachLocationgRPC) CheckCoachLocation(ctx context.Context, request *coachLocationProtobuf.CheckCoachLocationRequest) (*coachLocationProtobuf.CheckCoachLocationResponse, error) {
+	v := validation.New()
+	coachId := v.UUID("coach_id", request.CoachId)
+	locationId := v.UUID("location_id", request.LocationId)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	coachLocation, err := c.locationUseCase.GetCoachLocation(ctx, coachId, locationId, !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		if errors.Is(err, customErrors.CoachNotAtLocation) {
+			return &coachLocationProtobuf.CheckCoachLocationResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	return &coachLocationProtobuf.CheckCoachLocationResponse{
+		Assigned: true,
+		Primary:  coachLocation.Primary,
+	}, nil
+}
+
+// coachesFilter reads the optional LocationMetadataKey and SortMetadataKey of
+// a public coach listing, which only ever lists public coaches
+func coachesFilter(ctx context.Context) (dtos.CoachesFilter, error) {
+	filter := dtos.CoachesFilter{PublicOnly: true}
+
+	if locationIds := metadata.ValueFromIncomingContext(ctx, LocationMetadataKey); len(locationIds) > 0 {
+		locationId, err := validation.LocationId(LocationMetadataKey, locationIds[0])
+		if err != nil {
+			return filter, err
+		}
+		filter.LocationId = locationId
+	}
+
+	if sorts := metadata.ValueFromIncomingContext(ctx, SortMetadataKey); len(sorts) > 0 {
+		sortBy, err := validation.CoachesSort(SortMetadataKey, sorts[0])
+		if err != nil {
+			return filter, err
+		}
+		filter.SortBy = sortBy
+	}
+
+	//favorites are those of the caller
+	if filter.SortBy == dtos.CoachesFavoritesFirst {
+		userId, err := callerUserId(ctx)
+		if err != nil {
+			return filter, err
+		}
+		filter.UserId = userId
+	}
+
+	return filter, nil
+}
+
+func locationObject(location *models.Location) *coachLocationProtobuf.LocationObject {
+	return &coachLocationProtobuf.LocationObject{
+		Id:          location.Id.String(),
+		Name:        location.Name,
+		Address:     location.Address,
+		CreatedTime: location.CreatedTime.String(),
+		UpdatedTime: location.UpdatedTime.String(),
+	}
+}
+
+func coachLocationObjects(coachLocations []*models.CoachLocation) []*coachLocationProtobuf.CoachLocationObject {
+	objects := make([]*coachLocationProtobuf.CoachLocationObject, 0, len(coachLocations))
+	for _, coachLocation := range coachLocations {
+		objects = append(objects, &coachLocationProtobuf.CoachLocationObject{
+			Location: locationObject(&coachLocation.Location),
+			Primary:  coachLocation.Primary,
+		})
+	}
+
+	return objects
+}
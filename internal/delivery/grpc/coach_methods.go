@@ -2,26 +2,34 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
 	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
 	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
 	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
 	serviceGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"io"
 	"reflect"
-	"strings"
 	"time"
 )
 
 var _ coachProtobuf.CoachServer = (*CoachgRPC)(nil)
 
+// unavailableSectionsKey is the trailer listing the sections left out of a
+// degraded aggregated response
+const unavailableSectionsKey = "x-unavailable-sections"
+
 type CoachgRPC struct {
 	coachProtobuf.UnimplementedCoachServer
 
@@ -46,6 +54,7 @@ func RegisterCoachServer(
 }
 
 func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.CreateCoachRequest, coachProtobuf.CreateCoachResponse]) error {
+	ctx := g.Context()
 
 	coachData, coachPhoto, err := GetObjectData(
 		&g,
@@ -60,15 +69,11 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 		return status.Error(codes.InvalidArgument, "invalid request data")
 	}
 
-	if coachData == nil {
-		logger.ErrorLogger.Printf("coach data is empty")
-		return status.Error(codes.InvalidArgument, "coach data is empty")
-	}
+	//a missing coach data leaves castedCoachData nil, which validation reports
+	castedCoachData, _ := coachData.(*coachProtobuf.CoachDataForCreate)
 
-	castedCoachData, ok := coachData.(*coachProtobuf.CoachDataForCreate)
-	if !ok {
-		logger.ErrorLogger.Printf("coach data is not of type CoachProtobuf.CoachDataForCreate")
-		return status.Error(codes.InvalidArgument, "coach data is not of type CoachProtobuf.CoachDataForCreate")
+	if err = validation.CoachDataForCreate(castedCoachData, coachPhoto); err != nil {
+		return err
 	}
 
 	cmd := &dtos.CreateCoachCommand{
@@ -76,22 +81,24 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 		Name:        castedCoachData.Name,
 		Description: castedCoachData.Description,
 	}
+	ctx = logger.WithCoachID(ctx, cmd.Id.String())
 
 	var photoURL string
 	if coachPhoto != nil {
-		url, err := c.cloudUseCase.PutObject(context.TODO(), coachPhoto, "coach/"+cmd.Id.String())
+		url, err := c.cloudUseCase.PutObject(ctx, coachPhoto, "coach/"+cmd.Id.String())
 		photoURL = url
 		if err != nil {
-			logger.ErrorLogger.Printf("Failed to create coach photo in cloud: %v", err)
-			return status.Error(codes.Internal, "Failed to create coach photo in cloud")
+			return err
 		}
+
+		metrics.PhotosUploaded.Inc()
 	}
 
 	cmd.Photo = photoURL
 
-	coach, err := c.coachUseCase.CreateCoach(context.TODO(), cmd)
+	coach, err := c.coachUseCase.CreateCoach(ctx, cmd)
 	if err != nil {
-		return status.Error(codes.Internal, "Failed to create coach")
+		return err
 	}
 
 	createCoachServicesRequest := &serviceGRPC.CreateCoachServicesRequest{
@@ -100,9 +107,9 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 			ServiceId: castedCoachData.CoachServiceIds,
 		},
 	}
-	services, err := (*c.serviceClient).CreateCoachServices(context.TODO(), createCoachServicesRequest)
+	services, err := (*c.serviceClient).CreateCoachServices(ctx, createCoachServicesRequest)
 	if err != nil {
-		return err
+		return customErrors.DependencyFailure(string(models.ServiceDependency), err)
 	}
 
 	var coachsServices *serviceGRPC.GetCoachesServicesResponse
@@ -110,9 +117,9 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 		getCoachesServicesRequest := &serviceGRPC.GetCoachesServicesRequest{
 			CoachIds: []string{coach.Id.String()},
 		}
-		coachsServices, err = (*c.serviceClient).GetCoachesServices(context.TODO(), getCoachesServicesRequest)
+		coachsServices, err = (*c.serviceClient).GetCoachesServices(ctx, getCoachesServicesRequest)
 		if err != nil {
-			return err
+			return customErrors.DependencyFailure(string(models.ServiceDependency), err)
 		}
 	}
 
@@ -126,7 +133,8 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 	}
 
 	var coachWithServices *coachProtobuf.CoachWithServices
-	if coachsServices != nil {
+	//the Service service may list nothing for a coach without services
+	if coachsServices != nil && len(coachsServices.CoachIdsWithServices) > 0 {
 		coachWithServices = &coachProtobuf.CoachWithServices{
 			Coach:    coachObject,
 			Services: coachsServices.CoachIdsWithServices[0].ServiceObjects,
@@ -144,7 +152,7 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 
 	err = g.SendAndClose(response)
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to send coach create response: %v", err)
+		logger.Error(ctx, "Failed to send coach create response", "error", err)
 		return status.Error(codes.Internal, "Failed to send coach create response")
 	}
 
@@ -153,16 +161,22 @@ func (c *CoachgRPC) CreateCoach(g grpc.ClientStreamingServer[coachProtobuf.Creat
 
 func (c *CoachgRPC) GetCoachById(ctx context.Context, request *coachProtobuf.GetCoachByIdRequest) (*coachProtobuf.GetCoachByIdResponse, error) {
 
-	coach, err := c.coachUseCase.GetCoachById(ctx, uuid.MustParse(request.Id))
+	coachId, err := validation.CoachId("id", request.Id)
 	if err != nil {
+		return nil, err
+	}
 
-		if errors.Is(err, customErrors.CoachNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
+	ctx = logger.WithCoachID(ctx, request.Id)
 
+	coach, err := c.coachUseCase.GetCoachById(ctx, coachId)
+	if err != nil {
 		return nil, err
 	}
 
+	if !coach.IsPublic(time.Now()) && !canSeeUnpublished(ctx, request.Id) {
+		return nil, customErrors.NotFound("coach", request.Id, customErrors.CoachNotFound)
+	}
+
 	coachObject := &coachProtobuf.CoachObject{
 		Id:          coach.Id.String(),
 		Name:        coach.Name,
@@ -180,6 +194,8 @@ func (c *CoachgRPC) GetCoachById(ctx context.Context, request *coachProtobuf.Get
 }
 
 func (c *CoachgRPC) UpdateCoach(g grpc.ClientStreamingServer[coachProtobuf.UpdateCoachRequest, coachProtobuf.UpdateCoachResponse]) error {
+	ctx := g.Context()
+
 	coachData, coachPhoto, err := GetObjectData(
 		&g,
 		func(chunk *coachProtobuf.UpdateCoachRequest) interface{} {
@@ -193,89 +209,86 @@ func (c *CoachgRPC) UpdateCoach(g grpc.ClientStreamingServer[coachProtobuf.Updat
 		return status.Error(codes.InvalidArgument, "invalid request data")
 	}
 
-	if coachData == nil {
-		logger.ErrorLogger.Printf("coach data is empty")
-		return status.Error(codes.InvalidArgument, "coach data is empty")
+	//a missing coach data leaves castedCoachData nil, which validation reports
+	castedCoachData, _ := coachData.(*coachProtobuf.CoachDataForUpdate)
+
+	coachId, err := validation.CoachDataForUpdate(castedCoachData, coachPhoto)
+	if err != nil {
+		return err
 	}
 
-	castedCoachData, ok := coachData.(*coachProtobuf.CoachDataForUpdate)
-	if !ok {
-		logger.ErrorLogger.Printf("coach data is not of type CoachProtobuf.CoachDataForCreate")
-		return status.Error(codes.InvalidArgument, "coach data is not of type CoachProtobuf.CoachDataForCreate")
+	ctx = logger.WithCoachID(ctx, castedCoachData.Id)
+
+	if err = auth.AuthorizeCoachEdit(ctx, castedCoachData.Id); err != nil {
+		return err
 	}
 
 	cmd := &dtos.UpdateCoachCommand{
-		Id:          uuid.MustParse(castedCoachData.Id),
+		Id:          coachId,
 		Name:        castedCoachData.Name,
 		Description: castedCoachData.Description,
 		UpdatedTime: time.Now(),
 	}
 
-	existingCoach, err := c.coachUseCase.GetCoachById(context.TODO(), uuid.MustParse(castedCoachData.Id))
+	//the stored coach is needed, not its translation into the caller's locale
+	existingCoach, err := c.coachUseCase.GetCoachById(locale.WithLocale(ctx, ""), coachId)
 	if err != nil {
-		return status.Error(codes.NotFound, "coach not found")
+		return err
 	}
 
-	var photoURL string
-	randomID := uuid.New().String()
-	if coachPhoto != nil {
-		if existingCoach.Photo != "" {
-			prefix := "coach/"
-			index := strings.Index(existingCoach.Photo, prefix)
-			var s3PhotoKey string
-			if index != -1 {
-				s3PhotoKey = existingCoach.Photo[index+len(prefix):]
-			} else {
-				logger.ErrorLogger.Printf("Prefix not found")
-			}
-
-			exists, err := c.cloudUseCase.ObjectExists(context.TODO(), "coach/"+s3PhotoKey)
-			if err != nil {
-				return status.Error(codes.Internal, "can't find previous photo meta")
-			}
-
-			if exists {
-				err := c.cloudUseCase.DeleteObject(context.TODO(), "coach/"+s3PhotoKey)
-				if err != nil {
-					return err
-				}
-			}
+	selfEdit := !auth.PrincipalFromContext(ctx).IsAdmin()
+	if selfEdit {
+		if err = restrictSelfEdit(existingCoach, cmd.Name, castedCoachData.CoachServiceIds); err != nil {
+			return err
 		}
+	}
 
-		url, err := c.cloudUseCase.PutObject(context.TODO(), coachPhoto, "coach/"+randomID)
+	var photoURL string
+	if coachPhoto != nil {
+		url, err := c.cloudUseCase.PutObject(ctx, coachPhoto, usecase.CoachPhotoPrefix+uuid.New().String())
 		photoURL = url
 		if err != nil {
-			logger.ErrorLogger.Printf("Failed to create coach photo in cloud: %v", err)
-			return status.Error(codes.Internal, "Failed to create coach photo in cloud")
+			return err
 		}
+
+		metrics.PhotosUploaded.Inc()
 	}
 
 	cmd.Photo = photoURL
 
-	coach, err := c.coachUseCase.UpdateCoach(context.TODO(), cmd)
+	coach, err := c.coachUseCase.UpdateCoach(ctx, cmd)
 	if err != nil {
-		return status.Error(codes.Internal, "Failed to create coach")
+		return err
 	}
 
-	updateCoachServicesRequest := &serviceGRPC.UpdateCoachServicesRequest{
-		CoachService: &serviceGRPC.CoachService{
-			CoachId:   coach.Id.String(),
-			ServiceId: castedCoachData.CoachServiceIds,
-		},
+	//the old photo goes once the coach points at the new one
+	if coachPhoto != nil {
+		usecase.DeleteReplacedPhoto(ctx, c.cloudUseCase, existingCoach.Photo)
 	}
-	services, err := (*c.serviceClient).UpdateCoachServices(context.TODO(), updateCoachServicesRequest)
-	if err != nil {
-		return err
+
+	//coaches editing themselves leave their services as they are
+	var services *serviceGRPC.UpdateCoachServicesResponse
+	if !selfEdit {
+		updateCoachServicesRequest := &serviceGRPC.UpdateCoachServicesRequest{
+			CoachService: &serviceGRPC.CoachService{
+				CoachId:   coach.Id.String(),
+				ServiceId: castedCoachData.CoachServiceIds,
+			},
+		}
+		services, err = (*c.serviceClient).UpdateCoachServices(ctx, updateCoachServicesRequest)
+		if err != nil {
+			return customErrors.DependencyFailure(string(models.ServiceDependency), err)
+		}
 	}
 
 	var coachesServices *serviceGRPC.GetCoachesServicesResponse
-	if services != nil {
+	if selfEdit || services != nil {
 		getCoachesServicesRequest := &serviceGRPC.GetCoachesServicesRequest{
 			CoachIds: []string{coach.Id.String()},
 		}
-		coachesServices, err = (*c.serviceClient).GetCoachesServices(context.TODO(), getCoachesServicesRequest)
+		coachesServices, err = (*c.serviceClient).GetCoachesServices(ctx, getCoachesServicesRequest)
 		if err != nil {
-			return err
+			return customErrors.DependencyFailure(string(models.ServiceDependency), err)
 		}
 	}
 
@@ -289,7 +302,8 @@ func (c *CoachgRPC) UpdateCoach(g grpc.ClientStreamingServer[coachProtobuf.Updat
 	}
 
 	var coachWithServices *coachProtobuf.CoachWithServices
-	if coachesServices != nil {
+	//the Service service may list nothing for a coach without services
+	if coachesServices != nil && len(coachesServices.CoachIdsWithServices) > 0 {
 		coachWithServices = &coachProtobuf.CoachWithServices{
 			Coach:    coachObject,
 			Services: coachesServices.CoachIdsWithServices[0].ServiceObjects,
@@ -307,7 +321,7 @@ func (c *CoachgRPC) UpdateCoach(g grpc.ClientStreamingServer[coachProtobuf.Updat
 
 	err = g.SendAndClose(response)
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to send coach update response: %v", err)
+		logger.Error(ctx, "Failed to send coach update response", "error", err)
 		return err
 	}
 
@@ -315,7 +329,14 @@ func (c *CoachgRPC) UpdateCoach(g grpc.ClientStreamingServer[coachProtobuf.Updat
 }
 
 func (c *CoachgRPC) DeleteCoachById(ctx context.Context, request *coachProtobuf.DeleteCoachByIdRequest) (*coachProtobuf.DeleteCoachByIdResponse, error) {
-	deletedCoach, err := c.coachUseCase.DeleteCoachById(ctx, uuid.MustParse(request.Id))
+	coachId, err := validation.CoachId("id", request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = logger.WithCoachID(ctx, request.Id)
+
+	deletedCoach, err := c.coachUseCase.DeleteCoachById(ctx, coachId)
 	if err != nil {
 		return nil, err
 	}
@@ -337,8 +358,12 @@ func (c *CoachgRPC) DeleteCoachById(ctx context.Context, request *coachProtobuf.
 }
 
 func (c *CoachgRPC) GetCoaches(ctx context.Context, _ *emptypb.Empty) (*coachProtobuf.GetCoachesResponse, error) {
+	filter, err := coachesFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	coaches, err := c.coachUseCase.GetCoaches(ctx)
+	coaches, err := c.coachUseCase.GetCoaches(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -365,12 +390,31 @@ func (c *CoachgRPC) GetCoaches(ctx context.Context, _ *emptypb.Empty) (*coachPro
 }
 
 func (c *CoachgRPC) GetCoachesWithServicesWithReviewsWithUsers(ctx context.Context, empty *emptypb.Empty) (*coachProtobuf.GetCoachesWithServicesWithReviewsWithUsersResponse, error) {
-	response, err := c.coachUseCase.GetCoachesWithServices(ctx)
+	filter, err := coachesFilter(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	coachesWithServices, err := c.coachUseCase.GetCoachesWithServices(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if coachesWithServices.Degraded() {
+		setUnavailableSectionsTrailer(ctx, coachesWithServices.UnavailableSections)
+	}
+
+	return coachesWithServices.Response, nil
+}
+
+func setUnavailableSectionsTrailer(ctx context.Context, sections []string) {
+	trailer := metadata.MD{}
+	trailer.Append(unavailableSectionsKey, sections...)
+
+	err := grpc.SetTrailer(ctx, trailer)
+	if err != nil {
+		logger.Error(ctx, "Failed to set unavailable sections trailer", "error", err)
+	}
 }
 
 func GetObjectData[T any, R any](
@@ -390,7 +434,7 @@ func GetObjectData[T any, R any](
 			break
 		}
 		if err != nil {
-			logger.ErrorLogger.Printf("Error getting chunk: %v", err)
+			logger.Error((*g).Context(), "Error getting chunk", "error", err)
 			return nil, nil, err
 		}
 
@@ -398,7 +442,8 @@ func GetObjectData[T any, R any](
 			objectData = ud
 		}
 
-		if uf := extractObjectPhoto(chunk); uf != nil {
+		//past the limit further chunks are dropped, validation reports the size
+		if uf := extractObjectPhoto(chunk); uf != nil && len(objectPhoto) <= validation.MaxPhotoBytes {
 			objectPhoto = append(objectPhoto, uf...)
 		}
 	}
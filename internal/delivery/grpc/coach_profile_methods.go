@@ -0,0 +1,227 @@
+package grpc
+
+import (
+	"context"
+	coachProfileProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_profile"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var _ coachProfileProtobuf.CoachProfileServer = (*CoachProfilegRPC)(nil)
+
+type CoachProfilegRPC struct {
+	coachProfileProtobuf.UnimplementedCoachProfileServer
+
+	coachUseCase       usecase.CoachUseCase
+	contactUseCase     usecase.ContactUseCase
+	reviewStatsUseCase usecase.ReviewStatsUseCase
+	favoriteUseCase    usecase.FavoriteUseCase
+}
+
+func RegisterCoachProfileServer(
+	gRPC *grpc.Server,
+	coachUseCase usecase.CoachUseCase,
+	contactUseCase usecase.ContactUseCase,
+	reviewStatsUseCase usecase.ReviewStatsUseCase,
+	favoriteUseCase usecase.FavoriteUseCase,
+) {
+	coachProfileProtobuf.RegisterCoachProfileServer(gRPC, &CoachProfilegRPC{
+		coachUseCase:       coachUseCase,
+		contactUseCase:     contactUseCase,
+		reviewStatsUseCase: reviewStatsUseCase,
+		favoriteUseCase:    favoriteUseCase,
+	})
+}
+
+func (c *CoachProfilegRPC) GetCoachProfile(ctx context.Context, request *coachProfileProtobuf.GetCoachProfileRequest) (*coachProfileProtobuf.GetCoachProfileResponse, error) {
+	id, err := validation.CoachId("id", request.Id)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, id.String())
+
+	coachProfile, err := c.coachUseCase.GetCoachProfile(ctx, id, !canSeeUnpublished(ctx, request.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	//the profile already checked the caller may see the coach
+	contacts, err := c.contactUseCase.GetCoachContacts(ctx, id, contactAudience(ctx), false)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewStats, err := c.reviewStatsUseCase.GetCoachReviewStats(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	favoriteStats, err := c.favoriteUseCase.GetCoachFavoriteStats(ctx, id, optionalCallerUserId(ctx), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if coachProfile.Degraded() {
+		setUnavailableSectionsTrailer(ctx, coachProfile.UnavailableSections)
+	}
+
+	response := &coachProfileProtobuf.GetCoachProfileResponse{
+		CoachProfile:  coachProfile.Profile,
+		Contacts:      contactObjects(contacts),
+		ReviewStats:   reviewStatsObject(reviewStats),
+		FavoriteStats: favoriteStatsObject(favoriteStats),
+	}
+
+	return response, nil
+}
+
+func (c *CoachProfilegRPC) LinkCoachUser(ctx context.Context, request *coachProfileProtobuf.LinkCoachUserRequest) (*coachProfileProtobuf.LinkCoachUserResponse, error) {
+	coachId, userId, err := validation.LinkCoachUser(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	coach, err := c.coachUseCase.LinkCoachUser(ctx, coachId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachProfileProtobuf.LinkCoachUserResponse{
+		CoachId: coach.Id.String(),
+		UserId:  coach.UserId.String(),
+	}, nil
+}
+
+func (c *CoachProfilegRPC) UnlinkCoachUser(ctx context.Context, request *coachProfileProtobuf.UnlinkCoachUserRequest) (*coachProfileProtobuf.UnlinkCoachUserResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	if _, err = c.coachUseCase.UnlinkCoachUser(ctx, coachId); err != nil {
+		return nil, err
+	}
+
+	return &coachProfileProtobuf.UnlinkCoachUserResponse{}, nil
+}
+
+func (c *CoachProfilegRPC) GetMyCoachProfile(ctx context.Context, _ *coachProfileProtobuf.GetMyCoachProfileRequest) (*coachProfileProtobuf.GetMyCoachProfileResponse, error) {
+	userId, err := callerUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coachProfile, err := c.coachUseCase.GetMyCoachProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	coachId, err := uuid.Parse(coachProfile.Profile.GetCoach().GetId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "coach profile has no valid id")
+	}
+
+	//coaches see every contact of their own
+	contacts, err := c.contactUseCase.GetCoachContacts(ctx, coachId, models.ContactStaff, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if coachProfile.Degraded() {
+		setUnavailableSectionsTrailer(ctx, coachProfile.UnavailableSections)
+	}
+
+	return &coachProfileProtobuf.GetMyCoachProfileResponse{
+		CoachProfile: coachProfile.Profile,
+		Contacts:     contactObjects(contacts),
+	}, nil
+}
+
+func (c *CoachProfilegRPC) UpdateMyCoachProfile(g grpc.ClientStreamingServer[coachProfileProtobuf.UpdateMyCoachProfileRequest, coachProfileProtobuf.UpdateMyCoachProfileResponse]) error {
+	ctx := g.Context()
+
+	userId, err := callerUserId(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, photo, err := GetObjectData(
+		&g,
+		func(chunk *coachProfileProtobuf.UpdateMyCoachProfileRequest) interface{} {
+			return chunk.GetData()
+		},
+		func(chunk *coachProfileProtobuf.UpdateMyCoachProfileRequest) []byte {
+			return chunk.GetPhotoChunk()
+		},
+	)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid request data")
+	}
+
+	profileData, _ := data.(*coachProfileProtobuf.MyCoachProfileData)
+	if err = validation.MyCoachProfileData(profileData, photo); err != nil {
+		return err
+	}
+
+	coach, err := c.coachUseCase.UpdateMyCoachProfile(ctx, &dtos.UpdateMyCoachProfileCommand{
+		UserId:      userId,
+		Description: profileData.GetDescription(),
+		Photo:       photo,
+		UpdatedTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return g.SendAndClose(&coachProfileProtobuf.UpdateMyCoachProfileResponse{
+		Coach: &coachProtobuf.CoachObject{
+			Id:          coach.Id.String(),
+			Name:        coach.Name,
+			Description: coach.Description,
+			Photo:       coach.Photo,
+			CreatedTime: coach.CreatedTime.String(),
+			UpdatedTime: coach.UpdatedTime.String(),
+		},
+	})
+}
+
+// callerUserId returns the User service id of the authenticated caller
+func callerUserId(ctx context.Context) (uuid.UUID, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	userId, err := uuid.Parse(principal.UserId)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "token subject is not a user id")
+	}
+
+	return userId, nil
+}
+
+// restrictSelfEdit rejects the changes coaches may not make to their own
+// profile: renaming them and reassigning their services are left to admins
+func restrictSelfEdit(existingCoach *models.Coach, name string, serviceIds []string) error {
+	if name != "" && name != existingCoach.Name {
+		return status.Error(codes.PermissionDenied, "coaches can't change their name")
+	}
+
+	if len(serviceIds) > 0 {
+		return status.Error(codes.PermissionDenied, "coaches can't change their services")
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	coachPublicationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_publication"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var _ coachPublicationProtobuf.CoachPublicationServer = (*CoachPublicationgRPC)(nil)
+
+type CoachPublicationgRPC struct {
+	coachPublicationProtobuf.UnimplementedCoachPublicationServer
+
+	coachUseCase usecase.CoachUseCase
+}
+
+func RegisterCoachPublicationServer(gRPC *grpc.Server, coachUseCase usecase.CoachUseCase) {
+	coachPublicationProtobuf.RegisterCoachPublicationServer(gRPC, &CoachPublicationgRPC{coachUseCase: coachUseCase})
+}
+
+func (c *CoachPublicationgRPC) TransitionCoach(ctx context.Context, request *coachPublicationProtobuf.TransitionCoachRequest) (*coachPublicationProtobuf.TransitionCoachResponse, error) {
+	coachId, coachStatus, err := validation.TransitionCoach(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	if err = auth.AuthorizeCoachEdit(ctx, coachId.String()); err != nil {
+		return nil, err
+	}
+
+	//coaches only submit their profile for review or withdraw it
+	principal := auth.PrincipalFromContext(ctx)
+	if !principal.IsAdmin() && coachStatus != models.CoachInReview && coachStatus != models.CoachDraft {
+		return nil, status.Error(codes.PermissionDenied, "only admins publish and archive coaches")
+	}
+
+	coach, err := c.coachUseCase.TransitionCoach(ctx, &dtos.TransitionCoachCommand{
+		CoachId:     coachId,
+		Status:      coachStatus,
+		UpdatedTime: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachPublicationProtobuf.TransitionCoachResponse{Publication: publicationObject(coach)}, nil
+}
+
+func (c *CoachPublicationgRPC) ScheduleCoach(ctx context.Context, request *coachPublicationProtobuf.ScheduleCoachRequest) (*coachPublicationProtobuf.ScheduleCoachResponse, error) {
+	coachId, publishAt, unpublishAt, err := validation.ScheduleCoach(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	coach, err := c.coachUseCase.ScheduleCoach(ctx, &dtos.ScheduleCoachCommand{
+		CoachId:     coachId,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+		UpdatedTime: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachPublicationProtobuf.ScheduleCoachResponse{Publication: publicationObject(coach)}, nil
+}
+
+func (c *CoachPublicationgRPC) GetCoachPublication(ctx context.Context, request *coachPublicationProtobuf.GetCoachPublicationRequest) (*coachPublicationProtobuf.GetCoachPublicationResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	if err = auth.AuthorizeCoachEdit(ctx, coachId.String()); err != nil {
+		return nil, err
+	}
+
+	coach, err := c.coachUseCase.GetCoachById(ctx, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachPublicationProtobuf.GetCoachPublicationResponse{Publication: publicationObject(coach)}, nil
+}
+
+func (c *CoachPublicationgRPC) GetCoachesByStatus(ctx context.Context, request *coachPublicationProtobuf.GetCoachesByStatusRequest) (*coachPublicationProtobuf.GetCoachesByStatusResponse, error) {
+	coachStatus, err := validation.GetCoachesByStatus(request)
+	if err != nil {
+		return nil, err
+	}
+
+	coaches, err := c.coachUseCase.GetCoaches(ctx, dtos.CoachesFilter{Status: coachStatus})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &coachPublicationProtobuf.GetCoachesByStatusResponse{}
+	for _, coach := range coaches {
+		response.Publications = append(response.Publications, publicationObject(coach))
+	}
+
+	return response, nil
+}
+
+// canSeeUnpublished lets admins and a coach reading their own profile see
+// coaches clients can't
+func canSeeUnpublished(ctx context.Context, coachId string) bool {
+	return auth.AuthorizeCoachEdit(ctx, coachId) == nil
+}
+
+func publicationObject(coach *models.Coach) *coachPublicationProtobuf.CoachPublicationObject {
+	object := &coachPublicationProtobuf.CoachPublicationObject{
+		CoachId:     coach.Id.String(),
+		Name:        coach.Name,
+		Status:      statusEnum(coach.Status),
+		Public:      coach.IsPublic(time.Now()),
+		UpdatedTime: coach.UpdatedTime.String(),
+	}
+	if coach.PublishAt != nil {
+		object.PublishAt = coach.PublishAt.Format(time.RFC3339)
+	}
+	if coach.UnpublishAt != nil {
+		object.UnpublishAt = coach.UnpublishAt.Format(time.RFC3339)
+	}
+
+	return object
+}
+
+func statusEnum(coachStatus models.CoachStatus) coachPublicationProtobuf.CoachStatus {
+	switch coachStatus {
+	case models.CoachDraft:
+		return coachPublicationProtobuf.CoachStatus_DRAFT
+	case models.CoachInReview:
+		return coachPublicationProtobuf.CoachStatus_IN_REVIEW
+	case models.CoachPublished:
+		return coachPublicationProtobuf.CoachStatus_PUBLISHED
+	case models.CoachArchived:
+		return coachPublicationProtobuf.CoachStatus_ARCHIVED
+	default:
+		return coachPublicationProtobuf.CoachStatus_COACH_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	coachReviewStatsProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_review_stats"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"time"
+)
+
+var _ coachReviewStatsProtobuf.CoachReviewStatsServer = (*CoachReviewStatsgRPC)(nil)
+
+type CoachReviewStatsgRPC struct {
+	coachReviewStatsProtobuf.UnimplementedCoachReviewStatsServer
+
+	reviewStatsUseCase usecase.ReviewStatsUseCase
+}
+
+func RegisterCoachReviewStatsServer(gRPC *grpc.Server, reviewStatsUseCase usecase.ReviewStatsUseCase) {
+	coachReviewStatsProtobuf.RegisterCoachReviewStatsServer(gRPC, &CoachReviewStatsgRPC{reviewStatsUseCase: reviewStatsUseCase})
+}
+
+func (c *CoachReviewStatsgRPC) GetCoachReviewStats(ctx context.Context, request *coachReviewStatsProtobuf.GetCoachReviewStatsRequest) (*coachReviewStatsProtobuf.GetCoachReviewStatsResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	stats, err := c.reviewStatsUseCase.GetCoachReviewStats(ctx, coachId, !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachReviewStatsProtobuf.GetCoachReviewStatsResponse{ReviewStats: reviewStatsObject(stats)}, nil
+}
+
+func (c *CoachReviewStatsgRPC) GetCoachesReviewStats(ctx context.Context, _ *coachReviewStatsProtobuf.GetCoachesReviewStatsRequest) (*coachReviewStatsProtobuf.GetCoachesReviewStatsResponse, error) {
+	filter, err := coachesFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := c.reviewStatsUseCase.GetCoachesReviewStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*coachReviewStatsProtobuf.ReviewStatsObject, 0, len(stats))
+	for _, coachStats := range stats {
+		objects = append(objects, reviewStatsObject(coachStats))
+	}
+
+	return &coachReviewStatsProtobuf.GetCoachesReviewStatsResponse{ReviewStats: objects}, nil
+}
+
+func (c *CoachReviewStatsgRPC) RefreshCoachReviewStats(ctx context.Context, request *coachReviewStatsProtobuf.RefreshCoachReviewStatsRequest) (*coachReviewStatsProtobuf.RefreshCoachReviewStatsResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	stats, err := c.reviewStatsUseCase.RefreshCoachReviewStats(ctx, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachReviewStatsProtobuf.RefreshCoachReviewStatsResponse{ReviewStats: reviewStatsObject(stats)}, nil
+}
+
+func reviewStatsObject(stats *models.CoachReviewStats) *coachReviewStatsProtobuf.ReviewStatsObject {
+	object := &coachReviewStatsProtobuf.ReviewStatsObject{
+		CoachId:             stats.CoachId.String(),
+		ReviewCount:         int32(stats.ReviewCount),
+		RecentReviewCount:   int32(stats.RecentCount),
+		PreviousReviewCount: int32(stats.PreviousCount),
+		Trend:               int32(stats.Trend()),
+	}
+	if stats.LastReviewTime != nil {
+		object.LastReviewTime = stats.LastReviewTime.Format(time.RFC3339)
+	}
+	if !stats.RefreshedTime.IsZero() {
+		object.RefreshedTime = stats.RefreshedTime.Format(time.RFC3339)
+	}
+
+	return object
+}
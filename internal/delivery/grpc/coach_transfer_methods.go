@@ -0,0 +1,239 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	coachTransferProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_transfer"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"io"
+)
+
+var _ coachTransferProtobuf.CoachTransferServer = (*CoachTransfergRPC)(nil)
+
+// MaxImportRows bounds a single import so its per-row results fit a response
+const MaxImportRows = 10000
+
+type CoachTransfergRPC struct {
+	coachTransferProtobuf.UnimplementedCoachTransferServer
+
+	coachTransferUseCase usecase.CoachTransferUseCase
+}
+
+func RegisterCoachTransferServer(gRPC *grpc.Server, coachTransferUseCase usecase.CoachTransferUseCase) {
+	coachTransferProtobuf.RegisterCoachTransferServer(gRPC, &CoachTransfergRPC{coachTransferUseCase: coachTransferUseCase})
+}
+
+// ImportCoaches applies records one by one; a failing record is reported in
+// its row result and doesn't stop the import
+func (c *CoachTransfergRPC) ImportCoaches(g grpc.ClientStreamingServer[coachTransferProtobuf.ImportCoachesRequest, coachTransferProtobuf.ImportCoachesResponse]) error {
+	ctx := g.Context()
+
+	first, err := g.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return customErrors.Invalid(customErrors.FieldViolation{Field: "options", Description: "is required"})
+		}
+
+		return err
+	}
+
+	options := first.GetOptions()
+	if options == nil {
+		return customErrors.Invalid(customErrors.FieldViolation{
+			Field:       "options",
+			Description: "must be the first message of the stream",
+		})
+	}
+
+	response := &coachTransferProtobuf.ImportCoachesResponse{DryRun: options.DryRun}
+
+	//a record is imported once the message after its photo chunks arrives
+	var record *coachTransferProtobuf.CoachRecord
+	var photo []byte
+
+	importPending := func() error {
+		if record == nil {
+			return nil
+		}
+
+		row := int32(len(response.Results) + 1)
+		if row > MaxImportRows {
+			return customErrors.Invalid(customErrors.FieldViolation{
+				Field:       "record",
+				Description: "import is limited to 10000 records",
+			})
+		}
+
+		result := c.importRecord(ctx, record, photo, options.DryRun)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result.Row = row
+
+		switch result.Action {
+		case coachTransferProtobuf.ImportRowResult_CREATED:
+			response.Created++
+		case coachTransferProtobuf.ImportRowResult_UPDATED:
+			response.Updated++
+		default:
+			response.Failed++
+		}
+
+		response.Results = append(response.Results, result)
+		record, photo = nil, nil
+
+		return nil
+	}
+
+	for {
+		request, err := g.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch payload := request.Payload.(type) {
+		case *coachTransferProtobuf.ImportCoachesRequest_Record:
+			if err = importPending(); err != nil {
+				return err
+			}
+
+			record = payload.Record
+			if record == nil {
+				record = &coachTransferProtobuf.CoachRecord{}
+			}
+		case *coachTransferProtobuf.ImportCoachesRequest_PhotoChunk:
+			if record == nil {
+				return customErrors.Invalid(customErrors.FieldViolation{
+					Field:       "photo_chunk",
+					Description: "must follow a record",
+				})
+			}
+
+			//past the limit further chunks are dropped, validation reports the size
+			if len(photo) <= validation.MaxPhotoBytes {
+				photo = append(photo, payload.PhotoChunk...)
+			}
+		default:
+			return customErrors.Invalid(customErrors.FieldViolation{
+				Field:       "options",
+				Description: "must only be sent first",
+			})
+		}
+	}
+
+	if err = importPending(); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Imported coaches",
+		"dry_run", response.DryRun,
+		"created", response.Created,
+		"updated", response.Updated,
+		"failed", response.Failed,
+	)
+
+	return g.SendAndClose(response)
+}
+
+func (c *CoachTransfergRPC) importRecord(
+	ctx context.Context,
+	record *coachTransferProtobuf.CoachRecord,
+	photo []byte,
+	dryRun bool,
+) *coachTransferProtobuf.ImportRowResult {
+	if err := validation.CoachRecord(record, photo); err != nil {
+		return failedRow(record, err)
+	}
+
+	result, err := c.coachTransferUseCase.ImportCoach(ctx, &dtos.ImportCoachCommand{
+		ExternalId:      record.ExternalId,
+		Name:            record.Name,
+		Description:     record.Description,
+		CoachServiceIds: record.CoachServiceIds,
+		PhotoURL:        record.PhotoUrl,
+		Photo:           photo,
+		DryRun:          dryRun,
+	})
+	if err != nil {
+		logger.Warn(ctx, "Failed to import coach", "external_id", record.ExternalId, "error", err)
+		return failedRow(record, err)
+	}
+
+	rowResult := &coachTransferProtobuf.ImportRowResult{
+		ExternalId: record.ExternalId,
+		Action:     coachTransferProtobuf.ImportRowResult_CREATED,
+	}
+	if result.Action == dtos.ImportUpdated {
+		rowResult.Action = coachTransferProtobuf.ImportRowResult_UPDATED
+	}
+	if result.CoachId != uuid.Nil {
+		rowResult.CoachId = result.CoachId.String()
+	}
+
+	return rowResult
+}
+
+// failedRow describes err for the caller; storage failures keep their
+// details in the logs as the errors interceptor does
+func failedRow(record *coachTransferProtobuf.CoachRecord, err error) *coachTransferProtobuf.ImportRowResult {
+	result := &coachTransferProtobuf.ImportRowResult{
+		ExternalId: record.GetExternalId(),
+		Action:     coachTransferProtobuf.ImportRowResult_FAILED,
+		Error:      err.Error(),
+	}
+
+	var customErr *customErrors.Error
+	if errors.As(err, &customErr) {
+		if customErr.Kind == customErrors.KindStorage {
+			result.Error = "internal error"
+		}
+
+		for _, violation := range customErr.Violations {
+			result.FieldViolations = append(result.FieldViolations, &coachTransferProtobuf.FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+	}
+
+	return result
+}
+
+func (c *CoachTransfergRPC) ExportCoaches(
+	_ *coachTransferProtobuf.ExportCoachesRequest,
+	g grpc.ServerStreamingServer[coachTransferProtobuf.ExportCoachesResponse],
+) error {
+	records, err := c.coachTransferUseCase.ExportCoaches(g.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		coachRecord := &coachTransferProtobuf.CoachRecord{
+			Id:              record.Coach.Id.String(),
+			Name:            record.Coach.Name,
+			Description:     record.Coach.Description,
+			CoachServiceIds: record.CoachServiceIds,
+			PhotoUrl:        record.Coach.Photo,
+		}
+		if record.Coach.ExternalId != nil {
+			coachRecord.ExternalId = *record.Coach.ExternalId
+		}
+
+		if err = g.Send(&coachTransferProtobuf.ExportCoachesResponse{Record: coachRecord}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	coachTranslationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_translation"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ coachTranslationProtobuf.CoachTranslationServer = (*CoachTranslationgRPC)(nil)
+
+type CoachTranslationgRPC struct {
+	coachTranslationProtobuf.UnimplementedCoachTranslationServer
+
+	translationUseCase usecase.TranslationUseCase
+}
+
+func RegisterCoachTranslationServer(gRPC *grpc.Server, translationUseCase usecase.TranslationUseCase) {
+	coachTranslationProtobuf.RegisterCoachTranslationServer(gRPC, &CoachTranslationgRPC{translationUseCase: translationUseCase})
+}
+
+func (c *CoachTranslationgRPC) SetCoachTranslation(ctx context.Context, request *coachTranslationProtobuf.SetCoachTranslationRequest) (*coachTranslationProtobuf.SetCoachTranslationResponse, error) {
+	coachId, tag, err := validation.SetCoachTranslation(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	if err = auth.AuthorizeCoachEdit(ctx, coachId.String()); err != nil {
+		return nil, err
+	}
+
+	//coaches translate their description only, see restrictSelfEdit
+	if !auth.PrincipalFromContext(ctx).IsAdmin() {
+		name, err := c.translationUseCase.GetCoachName(ctx, coachId, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if request.Name != name {
+			return nil, status.Error(codes.PermissionDenied, "coaches can't change their name")
+		}
+	}
+
+	translation, err := c.translationUseCase.SetCoachTranslation(ctx, &dtos.SetCoachTranslationCommand{
+		CoachId:     coachId,
+		Locale:      tag,
+		Name:        request.Name,
+		Description: request.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coachTranslationProtobuf.SetCoachTranslationResponse{Translation: translationObject(translation)}, nil
+}
+
+func (c *CoachTranslationgRPC) DeleteCoachTranslation(ctx context.Context, request *coachTranslationProtobuf.DeleteCoachTranslationRequest) (*coachTranslationProtobuf.DeleteCoachTranslationResponse, error) {
+	coachId, tag, err := validation.DeleteCoachTranslation(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	if err = auth.AuthorizeCoachEdit(ctx, coachId.String()); err != nil {
+		return nil, err
+	}
+
+	if err = c.translationUseCase.DeleteCoachTranslation(ctx, coachId, tag); err != nil {
+		return nil, err
+	}
+
+	return &coachTranslationProtobuf.DeleteCoachTranslationResponse{}, nil
+}
+
+func (c *CoachTranslationgRPC) GetCoachTranslations(ctx context.Context, request *coachTranslationProtobuf.GetCoachTranslationsRequest) (*coachTranslationProtobuf.GetCoachTranslationsResponse, error) {
+	coachId, err := validation.CoachId("coach_id", request.CoachId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, coachId.String())
+
+	translations, err := c.translationUseCase.GetCoachTranslations(ctx, coachId, !canSeeUnpublished(ctx, request.CoachId))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &coachTranslationProtobuf.GetCoachTranslationsResponse{DefaultLocale: c.translationUseCase.DefaultLocale()}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, translationObject(translation))
+	}
+
+	return response, nil
+}
+
+func translationObject(translation *models.CoachTranslation) *coachTranslationProtobuf.CoachTranslationObject {
+	return &coachTranslationProtobuf.CoachTranslationObject{
+		CoachId:     translation.CoachId.String(),
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+		UpdatedTime: translation.UpdatedTime.String(),
+	}
+}
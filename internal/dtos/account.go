@@ -0,0 +1,15 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// UpdateMyCoachProfileCommand holds the fields coaches may change about
+// themselves; empty ones are left unchanged
+type UpdateMyCoachProfileCommand struct {
+	UserId      uuid.UUID
+	Description string
+	Photo       []byte
+	UpdatedTime time.Time
+}
@@ -0,0 +1,36 @@
+package dtos
+
+import (
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type ImportAction string
+
+const (
+	ImportCreated ImportAction = "created"
+	ImportUpdated ImportAction = "updated"
+)
+
+// ImportCoachCommand upserts a coach by ExternalId; Photo, if set, takes
+// precedence over PhotoURL
+type ImportCoachCommand struct {
+	ExternalId      string
+	Name            string
+	Description     string
+	CoachServiceIds []string
+	PhotoURL        string
+	Photo           []byte
+	DryRun          bool
+}
+
+// ImportCoachResult.CoachId is uuid.Nil for a coach a dry run would create
+type ImportCoachResult struct {
+	CoachId uuid.UUID
+	Action  ImportAction
+}
+
+type CoachRecord struct {
+	Coach           *models.Coach
+	CoachServiceIds []string
+}
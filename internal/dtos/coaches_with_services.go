@@ -0,0 +1,29 @@
+package dtos
+
+import (
+	coachGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+)
+
+const (
+	ServicesSection = "services"
+	ReviewsSection  = "reviews"
+	UsersSection    = "users"
+)
+
+type CoachesWithServices struct {
+	Response            *coachGRPC.GetCoachesWithServicesWithReviewsWithUsersResponse
+	UnavailableSections []string
+}
+
+func (c *CoachesWithServices) Degraded() bool {
+	return len(c.UnavailableSections) > 0
+}
+
+type CoachProfile struct {
+	Profile             *coachGRPC.CoachWithServicesWithReviewsWithUsers
+	UnavailableSections []string
+}
+
+func (c *CoachProfile) Degraded() bool {
+	return len(c.UnavailableSections) > 0
+}
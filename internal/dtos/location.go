@@ -0,0 +1,55 @@
+package dtos
+
+import (
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+type CreateLocationCommand struct {
+	Id      uuid.UUID
+	Name    string
+	Address string
+}
+
+// UpdateLocationCommand leaves empty fields unchanged
+type UpdateLocationCommand struct {
+	Id          uuid.UUID
+	Name        string
+	Address     string
+	UpdatedTime time.Time
+}
+
+// SetCoachLocationsCommand replaces every location of a coach; PrimaryLocationId
+// must be one of LocationIds and is uuid.Nil only when LocationIds is empty
+type SetCoachLocationsCommand struct {
+	CoachId           uuid.UUID
+	LocationIds       []uuid.UUID
+	PrimaryLocationId uuid.UUID
+}
+
+// CoachesFilter narrows coach listings; a uuid.Nil LocationId lists the
+// coaches of every location and an empty Status those of every status.
+// PublicOnly keeps the coaches clients may see, see models.Coach.IsPublic.
+// UserId is the caller: FavoritesOnly keeps the coaches they favorited and
+// CoachesFavoritesFirst orders those first.
+type CoachesFilter struct {
+	LocationId    uuid.UUID
+	Status        models.CoachStatus
+	PublicOnly    bool
+	UserId        uuid.UUID
+	FavoritesOnly bool
+	SortBy        CoachesSort
+}
+
+// CoachesSort orders coach listings, by name within equal keys. The review
+// sorts put the most reviewed coaches first, see models.CoachReviewStats,
+// and coaches never refreshed last.
+type CoachesSort string
+
+const (
+	CoachesUnsorted       CoachesSort = ""
+	CoachesByReviewCount  CoachesSort = "review_count"
+	CoachesByReviewTrend  CoachesSort = "review_trend"
+	CoachesFavoritesFirst CoachesSort = "favorites"
+)
@@ -0,0 +1,22 @@
+package dtos
+
+import (
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+type TransitionCoachCommand struct {
+	CoachId     uuid.UUID
+	Status      models.CoachStatus
+	UpdatedTime time.Time
+}
+
+// ScheduleCoachCommand replaces the publication window of a coach; a nil
+// time leaves that side of the window open
+type ScheduleCoachCommand struct {
+	CoachId     uuid.UUID
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+	UpdatedTime time.Time
+}
@@ -0,0 +1,13 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+)
+
+// SetCoachTranslationCommand carries a normalized Locale
+type SetCoachTranslationCommand struct {
+	CoachId     uuid.UUID
+	Locale      string
+	Name        string
+	Description string
+}
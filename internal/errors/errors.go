@@ -1,9 +1,136 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	VoidCoachData      = errors.New("void coach data")
 	CoachAlreadyExists = errors.New("coach already exists")
 	CoachNotFound      = errors.New("coach not found")
+
+	LocationAlreadyExists = errors.New("location already exists")
+	LocationNotFound      = errors.New("location not found")
+	LocationInUse         = errors.New("location has assigned coaches")
+	CoachNotAtLocation    = errors.New("coach is not assigned to the location")
+
+	TranslationNotFound = errors.New("coach translation not found")
+
+	InvalidCoachTransition = errors.New("coach status doesn't allow the transition")
+
+	UserNotFound      = errors.New("user not found")
+	UserAlreadyLinked = errors.New("user is already linked to a coach")
 )
+
+// Kind classifies a domain error; the delivery layer maps every kind to one
+// gRPC code
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindNotFound
+	KindConflict
+	KindPrecondition
+	KindValidation
+	KindDependency
+	KindStorage
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not found"
+	case KindConflict:
+		return "conflict"
+	case KindPrecondition:
+		return "failed precondition"
+	case KindValidation:
+		return "validation"
+	case KindDependency:
+		return "dependency failure"
+	case KindStorage:
+		return "storage failure"
+	default:
+		return "unknown"
+	}
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is the error produced by repositories and use cases. Resource and Id
+// name the entity for not found, conflict and failed precondition errors, Dependency names the
+// failed service for dependency failures and Op the failed operation for
+// storage failures.
+type Error struct {
+	Kind       Kind
+	Resource   string
+	Id         string
+	Dependency string
+	Op         string
+	Violations []FieldViolation
+	Err        error
+}
+
+func (e *Error) Error() string {
+	switch e.Kind {
+	case KindValidation:
+		descriptions := make([]string, 0, len(e.Violations))
+		for _, violation := range e.Violations {
+			descriptions = append(descriptions, violation.Field+" "+violation.Description)
+		}
+
+		return "invalid request: " + strings.Join(descriptions, "; ")
+	case KindDependency:
+		return e.Dependency + " unavailable: " + e.Err.Error()
+	case KindStorage:
+		return e.Op + " failed: " + e.Err.Error()
+	default:
+		return e.Err.Error()
+	}
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NotFound reports a missing entity; err is the sentinel callers match on,
+// e.g. CoachNotFound
+func NotFound(resource, id string, err error) error {
+	return &Error{Kind: KindNotFound, Resource: resource, Id: id, Err: err}
+}
+
+func Conflict(resource, id string, err error) error {
+	return &Error{Kind: KindConflict, Resource: resource, Id: id, Err: err}
+}
+
+// FailedPrecondition reports an entity whose state doesn't allow the
+// operation, e.g. InvalidCoachTransition
+func FailedPrecondition(resource, id string, err error) error {
+	return &Error{Kind: KindPrecondition, Resource: resource, Id: id, Err: err}
+}
+
+func Invalid(violations ...FieldViolation) error {
+	return &Error{Kind: KindValidation, Violations: violations}
+}
+
+func DependencyFailure(dependency string, err error) error {
+	return &Error{Kind: KindDependency, Dependency: dependency, Err: err}
+}
+
+func StorageFailure(op string, err error) error {
+	return &Error{Kind: KindStorage, Op: op, Err: err}
+}
+
+// KindOf returns the kind of the outermost domain error in err's chain
+func KindOf(err error) Kind {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Kind
+	}
+
+	return KindUnknown
+}
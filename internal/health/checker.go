@@ -0,0 +1,149 @@
+package health
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+const (
+	// LivenessService is the empty service name, SERVING for as long as the
+	// process accepts RPCs
+	LivenessService = ""
+	// ReadinessService is SERVING only while every critical probe passes
+	ReadinessService = "readiness"
+)
+
+type Probe func(ctx context.Context) error
+
+type subsystem struct {
+	name     string
+	probe    Probe
+	critical bool
+}
+
+// Checker periodically runs the subsystem probes and publishes their results
+// through the standard grpc.health.v1 service.
+type Checker struct {
+	server       *grpcHealth.Server
+	interval     time.Duration
+	probeTimeout time.Duration
+	subsystems   []subsystem
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewChecker(interval, probeTimeout time.Duration) *Checker {
+	return &Checker{
+		server:       grpcHealth.NewServer(),
+		interval:     interval,
+		probeTimeout: probeTimeout,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// AddProbe registers a subsystem reported under its own service name; only
+// critical subsystems affect readiness.
+func (c *Checker) AddProbe(name string, critical bool, probe Probe) {
+	c.subsystems = append(c.subsystems, subsystem{name: name, probe: probe, critical: critical})
+	c.server.SetServingStatus(name, healthpb.HealthCheckResponse_UNKNOWN)
+}
+
+func (c *Checker) Register(gRPC *grpc.Server) {
+	healthpb.RegisterHealthServer(gRPC, c.server)
+}
+
+func (c *Checker) Start() {
+	c.server.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+	c.server.SetServingStatus(ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			c.probeAll()
+
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown stops probing and flips every service, liveness included, to
+// NOT_SERVING so balancers drain the instance before it stops.
+func (c *Checker) Shutdown() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+		<-c.done
+		c.server.Shutdown()
+	})
+}
+
+func (c *Checker) probeAll() {
+	ready := true
+
+	var wg sync.WaitGroup
+	results := make([]error, len(c.subsystems))
+	for i, s := range c.subsystems {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), c.probeTimeout)
+			defer cancel()
+
+			results[i] = s.probe(ctx)
+		}()
+	}
+	wg.Wait()
+
+	for i, s := range c.subsystems {
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if results[i] != nil {
+			logger.Error(context.Background(), "Health probe failed", "subsystem", s.name, "error", results[i])
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+
+			if s.critical {
+				ready = false
+			}
+		}
+
+		c.server.SetServingStatus(s.name, servingStatus)
+	}
+
+	readiness := healthpb.HealthCheckResponse_SERVING
+	if !ready {
+		readiness = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.server.SetServingStatus(ReadinessService, readiness)
+}
+
+// GRPCProbe asks a downstream server for its health. Servers without the
+// health service still prove they are reachable by answering Unimplemented.
+func GRPCProbe(conn *grpc.ClientConn) Probe {
+	client := healthpb.NewHealthClient(conn)
+
+	return func(ctx context.Context) error {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+
+		return err
+	}
+}
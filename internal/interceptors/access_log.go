@@ -0,0 +1,49 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// UnaryServerAccessLog logs one record per finished RPC; server side failures
+// are logged at error level, everything else at info
+func UnaryServerAccessLog() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logAccess(ctx, start, err)
+
+		return resp, err
+	}
+}
+
+func StreamServerAccessLog() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logAccess(ss.Context(), start, err)
+
+		return err
+	}
+}
+
+func logAccess(ctx context.Context, start time.Time, err error) {
+	code := status.Code(err)
+
+	args := []any{"code", code.String(), "duration", time.Since(start)}
+	if p, ok := peer.FromContext(ctx); ok {
+		args = append(args, "peer", p.Addr.String())
+	}
+
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.Unimplemented:
+		logger.Error(ctx, "rpc finished", append(args, "error", err)...)
+	default:
+		logger.Info(ctx, "rpc finished", args...)
+	}
+}
@@ -0,0 +1,108 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const authorizationKey = "authorization"
+
+// UnaryServerAuth verifies the bearer token, if any, and stores the principal
+// in the context; it reads no database, so it runs before the rate limiter,
+// which keys callers by their principal
+func UnaryServerAuth(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerAuth(verifier *auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryServerAuthorize links the principal stored by UnaryServerAuth to its
+// coach and enforces the policy rule of the called method; it runs after the
+// rate limiter, as resolving the coach may read the database
+func UnaryServerAuthorize(resolveCoach auth.CoachResolver, policy auth.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, resolveCoach, policy, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerAuthorize(resolveCoach auth.CoachResolver, policy auth.Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), resolveCoach, policy, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authenticate(ctx context.Context, verifier *auth.Verifier) (context.Context, error) {
+	authorization := metadata.ValueFromIncomingContext(ctx, authorizationKey)
+	if len(authorization) == 0 {
+		return ctx, nil
+	}
+
+	token, ok := strings.CutPrefix(authorization[0], "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+
+	principal, err := verifier.Verify(token)
+	if err != nil {
+		logger.Warn(ctx, "Rejected token", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+func authorize(ctx context.Context, resolveCoach auth.CoachResolver, policy auth.Policy, method string) error {
+	principal := auth.PrincipalFromContext(ctx)
+
+	//the coach.user_id link, not the token, proves which coach a user owns
+	if principal != nil && !principal.IsAdmin() {
+		coachId, err := resolveCoach(ctx, principal.UserId)
+		if err != nil {
+			logger.Error(ctx, "Failed to resolve the caller's coach", "error", err)
+			return status.Error(codes.Unavailable, "failed to resolve the caller's coach")
+		}
+		principal.CoachId = coachId
+	}
+
+	return policy.Authorize(method, principal)
+}
+
+// contextServerStream replaces the context of a wrapped server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
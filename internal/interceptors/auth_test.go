@@ -0,0 +1,124 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+const (
+	publicMethod = "/coach/GetCoaches"
+	coachMethod  = "/coach/UpdateCoach"
+	adminMethod  = "/coach/DeleteCoachById"
+)
+
+func TestAuthInterceptors(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := func(subject string, roles ...string) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"sub":   subject,
+			"roles": roles,
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}).SignedString(private)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return "Bearer " + signed
+	}
+
+	policy := auth.Policy{
+		publicMethod: auth.Public,
+		coachMethod:  auth.AdminOrCoach,
+	}
+	verifier := auth.NewVerifier(auth.KeySet{"": public}, "", "")
+
+	//user-1 owns coach-1, user-2 owns nothing, user-3 can't be resolved
+	resolveCoach := func(_ context.Context, userId string) (string, error) {
+		switch userId {
+		case "user-1":
+			return "coach-1", nil
+		case "user-3":
+			return "", errors.New("database is down")
+		default:
+			return "", nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		//editCoach makes the handler check AuthorizeCoachEdit for that coach
+		editCoach string
+		want      codes.Code
+	}{
+		{name: "anonymous public read", method: publicMethod, want: codes.OK},
+		{name: "anonymous coach method", method: coachMethod, want: codes.Unauthenticated},
+		{name: "not a bearer token", method: publicMethod, authorization: "Basic dXNlcjpwYXNz", want: codes.Unauthenticated},
+		{name: "invalid token", method: publicMethod, authorization: "Bearer not-a-token", want: codes.Unauthenticated},
+		{name: "unlisted method for a member", method: "/coach/Unlisted", authorization: token("user-2"), want: codes.PermissionDenied},
+		{name: "unlisted method for a coach", method: "/coach/Unlisted", authorization: token("user-1"), want: codes.PermissionDenied},
+		{name: "unlisted method for an admin", method: "/coach/Unlisted", authorization: token("admin-1", auth.AdminRole), want: codes.OK},
+		{name: "admin method for a coach", method: adminMethod, authorization: token("user-1"), want: codes.PermissionDenied},
+		{name: "coach method for a member", method: coachMethod, authorization: token("user-2"), want: codes.PermissionDenied},
+		{name: "coach editing their coach", method: coachMethod, authorization: token("user-1"), editCoach: "coach-1", want: codes.OK},
+		{name: "coach editing another coach", method: coachMethod, authorization: token("user-1"), editCoach: "coach-2", want: codes.PermissionDenied},
+		{name: "coach role without a linked coach", method: coachMethod, authorization: token("user-2", auth.CoachRole), editCoach: "coach-1", want: codes.PermissionDenied},
+		{name: "admin editing any coach", method: coachMethod, authorization: token("admin-1", auth.AdminRole), editCoach: "coach-2", want: codes.OK},
+		{name: "unresolvable coach", method: coachMethod, authorization: token("user-3"), want: codes.Unavailable},
+	}
+
+	chain := []grpc.UnaryServerInterceptor{
+		UnaryServerAuth(verifier),
+		UnaryServerAuthorize(resolveCoach, policy),
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationKey, test.authorization))
+			}
+
+			handler := func(ctx context.Context, _ any) (any, error) {
+				if test.editCoach != "" {
+					return nil, auth.AuthorizeCoachEdit(ctx, test.editCoach)
+				}
+				return nil, nil
+			}
+
+			_, err := runUnary(ctx, chain, test.method, handler)
+			if got := status.Code(err); got != test.want {
+				t.Errorf("code = %v, want %v (%v)", got, test.want, err)
+			}
+		})
+	}
+}
+
+// runUnary calls handler through the interceptors, the first one outermost
+func runUnary(ctx context.Context, chain []grpc.UnaryServerInterceptor, method string, handler grpc.UnaryHandler) (any, error) {
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		interceptor, next := chain[i], handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	return handler(ctx, nil)
+}
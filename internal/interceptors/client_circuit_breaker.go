@@ -0,0 +1,127 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+type circuitOpenError struct{}
+
+func (circuitOpenError) Error() string {
+	return "circuit breaker is open"
+}
+
+func (e circuitOpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+var errCircuitOpen error = circuitOpenError{}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitBreaker stops calling a dependency after FailureLimit consecutive
+// failures and lets a single probe through once OpenStateDelay has passed.
+type CircuitBreaker struct {
+	name   string
+	policy *ClientPolicy
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreaker(name string, policy *ClientPolicy) *CircuitBreaker {
+	return &CircuitBreaker{name: name, policy: policy}
+}
+
+func (cb *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if isHealthCheck(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if !cb.allow() {
+			return errCircuitOpen
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		cb.record(isDependencyFailure(err))
+
+		return err
+	}
+}
+
+func (cb *CircuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breakerOpen:
+		if time.Since(cb.openedAt) < cb.policy.OpenStateDelay {
+			return false
+		}
+
+		cb.state = breakerHalfOpen
+		cb.probing = true
+		return true
+	case breakerHalfOpen:
+		if cb.probing {
+			return false
+		}
+
+		cb.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (cb *CircuitBreaker) record(failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+
+	if !failed {
+		if cb.state != breakerClosed {
+			logger.Info(context.Background(), "Circuit breaker closed", "dependency", cb.name)
+		}
+
+		cb.state = breakerClosed
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.state == breakerHalfOpen || cb.failures >= cb.policy.FailureLimit {
+		if cb.state != breakerOpen {
+			logger.Error(context.Background(), "Circuit breaker opened", "dependency", cb.name, "failures", cb.failures)
+		}
+
+		cb.state = breakerOpen
+		cb.openedAt = time.Now()
+	}
+}
+
+// isDependencyFailure ignores errors caused by the request itself
+func isDependencyFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package interceptors
+
+import (
+	"context"
+	"google.golang.org/grpc"
+)
+
+// UnaryClientDeadline bounds every outgoing attempt by the policy timeout,
+// keeping a shorter deadline inherited from the incoming request.
+func UnaryClientDeadline(policy *ClientPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		timeout := policy.timeout(method)
+		if timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,84 @@
+package interceptors
+
+import (
+	"fmt"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"path"
+	"strings"
+	"time"
+)
+
+// ClientPolicy describes how calls to one downstream dependency are bounded
+// and retried.
+type ClientPolicy struct {
+	// Timeout is applied to every attempt unless MethodTimeouts overrides it
+	Timeout        time.Duration
+	MethodTimeouts map[string]time.Duration
+
+	MaxAttempts    int
+	BackoffBase    time.Duration
+	BackoffMax     time.Duration
+	IsIdempotent   func(method string) bool
+	FailureLimit   int
+	OpenStateDelay time.Duration
+}
+
+func DefaultClientPolicy() *ClientPolicy {
+	return &ClientPolicy{
+		Timeout:        3 * time.Second,
+		MethodTimeouts: map[string]time.Duration{},
+		MaxAttempts:    3,
+		BackoffBase:    100 * time.Millisecond,
+		BackoffMax:     2 * time.Second,
+		IsIdempotent:   IsReadMethod,
+		FailureLimit:   5,
+		OpenStateDelay: 10 * time.Second,
+	}
+}
+
+func (p *ClientPolicy) timeout(method string) time.Duration {
+	if timeout, ok := p.MethodTimeouts[method]; ok {
+		return timeout
+	}
+
+	return p.Timeout
+}
+
+// isHealthCheck reports a readiness probe of the dependency, which must see
+// the dependency as it is: it is neither retried nor counted by the breaker
+func isHealthCheck(method string) bool {
+	return method == healthpb.Health_Check_FullMethodName
+}
+
+// IsReadMethod treats Get* and Check* RPCs as safe to retry
+func IsReadMethod(method string) bool {
+	name := path.Base(method)
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "Check")
+}
+
+// ParseMethodTimeouts reads "full method=duration" pairs separated by commas,
+// e.g. "/fitness_center.review.Review/GetCoachesReviews=1s".
+func ParseMethodTimeouts(value string) (map[string]time.Duration, error) {
+	timeouts := map[string]time.Duration{}
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		method, rawTimeout, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid method timeout %q", pair)
+		}
+
+		timeout, err := time.ParseDuration(rawTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid method timeout %q: %w", pair, err)
+		}
+
+		timeouts[method] = timeout
+	}
+
+	return timeouts, nil
+}
@@ -0,0 +1,66 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/rand/v2"
+	"time"
+)
+
+// UnaryClientRetry retries idempotent calls failing with a transient code,
+// sleeping a fully jittered exponential backoff between attempts.
+func UnaryClientRetry(policy *ClientPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !policy.IsIdempotent(method) || isHealthCheck(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var err error
+		for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+			if attempt > 0 {
+				timer := time.NewTimer(backoff(policy, attempt))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+
+				logger.Info(ctx, "Retrying downstream call", "method", method, "attempt", attempt+1, "error", err)
+			}
+
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || !isRetryable(err) {
+				return err
+			}
+		}
+
+		return err
+	}
+}
+
+func backoff(policy *ClientPolicy, attempt int) time.Duration {
+	ceiling := policy.BackoffBase << (attempt - 1)
+	if ceiling <= 0 || ceiling > policy.BackoffMax {
+		ceiling = policy.BackoffMax
+	}
+
+	return rand.N(ceiling + 1)
+}
+
+func isRetryable(err error) bool {
+	if errors.Is(err, errCircuitOpen) {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,136 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the ErrorInfo domain of dependency and storage failures
+const errorDomain = "coach.fitnesscenter"
+
+// UnaryServerErrors translates the domain errors returned by handlers into
+// gRPC statuses; errors that already are statuses pass through unchanged
+func UnaryServerErrors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatus(ctx, err)
+		}
+
+		return resp, nil
+	}
+}
+
+func StreamServerErrors() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			return toStatus(ss.Context(), err)
+		}
+
+		return nil
+	}
+}
+
+func toStatus(ctx context.Context, err error) error {
+	var domainErr *customErrors.Error
+	if errors.As(err, &domainErr) {
+		return domainStatus(ctx, domainErr)
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	logger.Error(ctx, "Unclassified error", "error", err)
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+func domainStatus(ctx context.Context, err *customErrors.Error) error {
+	switch err.Kind {
+	case customErrors.KindNotFound:
+		return withDetails(codes.NotFound, err.Error(), &errdetails.ResourceInfo{
+			ResourceType: err.Resource,
+			ResourceName: err.Id,
+			Description:  err.Error(),
+		})
+	case customErrors.KindConflict:
+		return withDetails(codes.AlreadyExists, err.Error(), &errdetails.ResourceInfo{
+			ResourceType: err.Resource,
+			ResourceName: err.Id,
+			Description:  err.Error(),
+		})
+	case customErrors.KindPrecondition:
+		return withDetails(codes.FailedPrecondition, err.Error(), &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        err.Resource,
+				Subject:     err.Id,
+				Description: err.Error(),
+			}},
+		})
+	case customErrors.KindValidation:
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(err.Violations))
+		for _, violation := range err.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+
+		return withDetails(codes.InvalidArgument, err.Error(), &errdetails.BadRequest{FieldViolations: violations})
+	case customErrors.KindDependency:
+		logger.Error(ctx, "Dependency failure", "dependency", err.Dependency, "error", err.Err)
+
+		return withDetails(codes.Unavailable, err.Dependency+" unavailable", &errdetails.ErrorInfo{
+			Reason:   "DEPENDENCY_UNAVAILABLE",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"dependency": err.Dependency},
+		})
+	case customErrors.KindStorage:
+		if errors.Is(err, context.Canceled) {
+			return status.Error(codes.Canceled, err.Error())
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		}
+
+		logger.Error(ctx, "Storage failure", "op", err.Op, "error", err.Err)
+
+		//storage errors can leak schema or bucket details, so only the operation is reported
+		return withDetails(codes.Internal, "storage failure", &errdetails.ErrorInfo{
+			Reason:   "STORAGE_FAILURE",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"op": err.Op},
+		})
+	default:
+		logger.Error(ctx, "Unclassified error", "error", err)
+
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func withDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,47 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func UnaryServerMetrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeServer(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+func StreamServerMetrics() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeServer(info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// UnaryClientMetrics observes whole downstream calls, retries included
+func UnaryClientMetrics() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		metrics.ClientHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+		metrics.ClientHandlingSeconds.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+func observeServer(method string, start time.Time, err error) {
+	metrics.ServerHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+	metrics.ServerHandlingSeconds.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
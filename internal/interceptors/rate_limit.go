@@ -0,0 +1,224 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type RPCClass string
+
+const (
+	ReadClass   RPCClass = "read"
+	WriteClass  RPCClass = "write"
+	UploadClass RPCClass = "upload"
+)
+
+const (
+	retryAfterKey   = "retry-after"
+	limiterIdleTime = 10 * time.Minute
+)
+
+// Limit refills Rate tokens per second up to Burst
+type Limit struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+// ParseLimit reads "rate:burst", e.g. "5:10"
+func ParseLimit(value string) (Limit, error) {
+	rawRate, rawBurst, ok := strings.Cut(value, ":")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q, want rate:burst", value)
+	}
+
+	limitRate, err := strconv.ParseFloat(rawRate, 64)
+	if err != nil {
+		return Limit{}, fmt.Errorf("invalid limit rate %q: %w", value, err)
+	}
+
+	burst, err := strconv.Atoi(rawBurst)
+	if err != nil {
+		return Limit{}, fmt.Errorf("invalid limit burst %q: %w", value, err)
+	}
+
+	return Limit{Rate: rate.Limit(limitRate), Burst: burst}, nil
+}
+
+// RateLimitPolicy gives every client a token bucket per RPC class and caps
+// the uploads in flight across all clients
+type RateLimitPolicy struct {
+	Limits             map[RPCClass]Limit
+	Classes            map[string]RPCClass
+	MaxInFlightUploads int
+}
+
+func (p *RateLimitPolicy) class(method string) RPCClass {
+	if class, ok := p.Classes[method]; ok {
+		return class
+	}
+
+	if IsReadMethod(method) {
+		return ReadClass
+	}
+
+	return WriteClass
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type RateLimiter struct {
+	policy  *RateLimitPolicy
+	uploads chan struct{}
+
+	mu       sync.Mutex
+	limiters map[string]*clientLimiter
+	swept    time.Time
+}
+
+// NewRateLimiter leaves uploads in flight unbounded when MaxInFlightUploads
+// is not positive
+func NewRateLimiter(policy *RateLimitPolicy) *RateLimiter {
+	rl := &RateLimiter{
+		policy:   policy,
+		limiters: map[string]*clientLimiter{},
+		swept:    time.Now(),
+	}
+
+	if policy.MaxInFlightUploads > 0 {
+		rl.uploads = make(chan struct{}, policy.MaxInFlightUploads)
+	}
+
+	return rl
+}
+
+func (rl *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := rl.acquire(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+func (rl *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := rl.acquire(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+func (rl *RateLimiter) acquire(ctx context.Context, method string) (func(), error) {
+	class := rl.policy.class(method)
+
+	limit, ok := rl.policy.Limits[class]
+	if ok {
+		if delay := rl.reserve(clientKey(ctx)+"|"+string(class), limit); delay > 0 {
+			return nil, resourceExhausted(ctx, "rate limit exceeded for "+string(class)+" requests", delay)
+		}
+	}
+
+	if class != UploadClass || rl.uploads == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case rl.uploads <- struct{}{}:
+		return func() { <-rl.uploads }, nil
+	default:
+		return nil, resourceExhausted(ctx, "too many uploads in progress", time.Second)
+	}
+}
+
+// reserve takes a token and returns zero, or how long until one is available
+func (rl *RateLimiter) reserve(key string, limit Limit) time.Duration {
+	now := time.Now()
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if now.Sub(rl.swept) > limiterIdleTime {
+		for limiterKey, cl := range rl.limiters {
+			if now.Sub(cl.lastSeen) > limiterIdleTime {
+				delete(rl.limiters, limiterKey)
+			}
+		}
+		rl.swept = now
+	}
+
+	cl, ok := rl.limiters[key]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(limit.Rate, limit.Burst)}
+		rl.limiters[key] = cl
+	}
+	cl.lastSeen = now
+
+	reservation := cl.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Second
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+
+	return delay
+}
+
+// clientKey identifies authenticated callers by user and others by address
+func clientKey(ctx context.Context) string {
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		return "user:" + principal.UserId
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+
+		return "peer:" + host
+	}
+
+	return "unknown"
+}
+
+func resourceExhausted(ctx context.Context, message string, retryAfter time.Duration) error {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterKey, strconv.Itoa(seconds)))
+
+	st, err := status.New(codes.ResourceExhausted, message).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, message)
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,165 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+const uploadMethod = "/coach/CreateCoach"
+
+func newTestRateLimiter(maxInFlightUploads int) *RateLimiter {
+	return NewRateLimiter(&RateLimitPolicy{
+		Limits: map[RPCClass]Limit{
+			ReadClass:   {Rate: 50, Burst: 2},
+			WriteClass:  {Rate: rate.Inf},
+			UploadClass: {Rate: rate.Inf},
+		},
+		Classes:            map[string]RPCClass{uploadMethod: UploadClass},
+		MaxInFlightUploads: maxInFlightUploads,
+	})
+}
+
+func callAs(rl *RateLimiter, userId, method string, handler grpc.UnaryHandler) error {
+	ctx := context.Background()
+	if userId != "" {
+		ctx = auth.WithPrincipal(ctx, &auth.Principal{UserId: userId})
+	}
+	if handler == nil {
+		handler = func(context.Context, any) (any, error) { return nil, nil }
+	}
+
+	_, err := runUnary(ctx, []grpc.UnaryServerInterceptor{rl.UnaryServerInterceptor()}, method, handler)
+	return err
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	rl := newTestRateLimiter(0)
+
+	steps := []struct {
+		name   string
+		userId string
+		wait   time.Duration
+		want   codes.Code
+	}{
+		{name: "first of the burst", userId: "user-1", want: codes.OK},
+		{name: "second of the burst", userId: "user-1", want: codes.OK},
+		{name: "burst exhausted", userId: "user-1", want: codes.ResourceExhausted},
+		{name: "other client keyed separately", userId: "user-2", want: codes.OK},
+		{name: "anonymous keyed separately", want: codes.OK},
+		{name: "refilled", userId: "user-1", wait: 50 * time.Millisecond, want: codes.OK},
+	}
+
+	for _, step := range steps {
+		time.Sleep(step.wait)
+
+		err := callAs(rl, step.userId, "/coach/GetCoaches", nil)
+		if got := status.Code(err); got != step.want {
+			t.Fatalf("%s: code = %v, want %v (%v)", step.name, got, step.want, err)
+		}
+	}
+}
+
+func TestRateLimiterRetryInfo(t *testing.T) {
+	rl := newTestRateLimiter(0)
+
+	var err error
+	for i := 0; i < 3; i++ {
+		err = callAs(rl, "user-1", "/coach/GetCoaches", nil)
+	}
+
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("code = %v, want ResourceExhausted", st.Code())
+	}
+
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			if delay := retryInfo.RetryDelay.AsDuration(); delay <= 0 || delay > time.Second {
+				t.Errorf("RetryDelay = %v, want the time until the next token", delay)
+			}
+			return
+		}
+	}
+
+	t.Errorf("details = %v, want a RetryInfo", st.Details())
+}
+
+func TestRateLimiterClasses(t *testing.T) {
+	tests := []struct {
+		method string
+		want   RPCClass
+	}{
+		{"/coach/GetCoaches", ReadClass},
+		{"/coach/CheckCoachLocation", ReadClass},
+		{"/coach/UpdateCoach", WriteClass},
+		{uploadMethod, UploadClass},
+	}
+
+	rl := newTestRateLimiter(0)
+	for _, test := range tests {
+		if got := rl.policy.class(test.method); got != test.want {
+			t.Errorf("class(%s) = %s, want %s", test.method, got, test.want)
+		}
+	}
+}
+
+func TestRateLimiterUploadSemaphore(t *testing.T) {
+	rl := newTestRateLimiter(1)
+
+	failing := func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.InvalidArgument, "photo too large")
+	}
+	if err := callAs(rl, "user-1", uploadMethod, failing); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("failed upload: code = %v, want InvalidArgument", status.Code(err))
+	}
+
+	//a failed upload released its slot, so the next one gets in
+	entered := make(chan struct{})
+	finish := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- callAs(rl, "user-1", uploadMethod, func(context.Context, any) (any, error) {
+			close(entered)
+			<-finish
+			return nil, nil
+		})
+	}()
+	<-entered
+
+	//while it runs, the only slot is taken, whoever asks
+	if err := callAs(rl, "user-2", uploadMethod, nil); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("concurrent upload: code = %v, want ResourceExhausted", status.Code(err))
+	}
+
+	close(finish)
+	if err := <-done; err != nil {
+		t.Fatalf("upload after a failed one: %v", err)
+	}
+
+	if err := callAs(rl, "user-2", uploadMethod, nil); err != nil {
+		t.Errorf("upload after the slot was released: %v", err)
+	}
+}
+
+func TestRateLimiterUploadSemaphoreReleasedOnPanic(t *testing.T) {
+	rl := newTestRateLimiter(1)
+
+	func() {
+		defer func() { _ = recover() }()
+		_ = callAs(rl, "user-1", uploadMethod, func(context.Context, any) (any, error) {
+			panic(errors.New("handler bug"))
+		})
+	}()
+
+	if err := callAs(rl, "user-1", uploadMethod, nil); err != nil {
+		t.Errorf("upload after a panicking one: %v", err)
+	}
+}
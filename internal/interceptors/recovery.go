@@ -0,0 +1,44 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"runtime/debug"
+)
+
+// UnaryServerRecovery turns a handler panic into an Internal status, logging
+// the panic value and stack with the request context
+func UnaryServerRecovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = recoverPanic(ctx, info.FullMethod, recovered)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerRecovery() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = recoverPanic(ss.Context(), info.FullMethod, recovered)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(ctx context.Context, method string, recovered any) error {
+	metrics.ServerPanics.WithLabelValues(method).Inc()
+	logger.Error(ctx, "Recovered from handler panic", "panic", recovered, "stack", string(debug.Stack()))
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,93 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxRequestIDLength bounds caller supplied request IDs, which end up in
+// every log record of the request
+const maxRequestIDLength = 128
+
+// UnaryServerRequestID stores the caller's request ID, or a new one, and the
+// caller's locale in the context, and echoes the request ID in the headers
+func UnaryServerRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, requestID := requestScope(ctx)
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIDKey, requestID)); err != nil {
+			logger.Warn(ctx, "Failed to set request ID header", "error", err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := requestScope(ss.Context())
+
+		if err := ss.SetHeader(metadata.Pairs(logger.RequestIDKey, requestID)); err != nil {
+			logger.Warn(ctx, "Failed to set request ID header", "error", err)
+		}
+
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func requestScope(ctx context.Context) (context.Context, string) {
+	var requestID string
+	if requestIDs := metadata.ValueFromIncomingContext(ctx, logger.RequestIDKey); len(requestIDs) > 0 && validRequestID(requestIDs[0]) {
+		requestID = requestIDs[0]
+	} else {
+		requestID = uuid.New().String()
+	}
+
+	ctx = logger.WithRequestID(ctx, requestID)
+
+	if requestLocale := locale.FromContext(ctx); requestLocale != "" {
+		ctx = locale.WithLocale(ctx, requestLocale)
+	}
+
+	return ctx, requestID
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range requestID {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UnaryClientMetadata forwards the request ID and locale of the incoming
+// request to downstream services
+func UnaryClientMetadata() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingScope(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+func outgoingScope(ctx context.Context) context.Context {
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+
+	if requestID := logger.RequestID(ctx); requestID != "" && len(outgoing.Get(logger.RequestIDKey)) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, logger.RequestIDKey, requestID)
+	}
+
+	if requestLocale := locale.FromContext(ctx); requestLocale != "" && len(outgoing.Get(locale.MetadataKey)) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, locale.MetadataKey, requestLocale)
+	}
+
+	return ctx
+}
@@ -0,0 +1,30 @@
+package locale
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata key carrying the caller's preferred locales,
+// in Accept-Language form, e.g. "pl-PL, en;q=0.8"
+const MetadataKey = "accept-language"
+
+type contextKey struct{}
+
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale stored in ctx, falling back to the one sent
+// by the caller in the incoming metadata
+func FromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(contextKey{}).(string); ok {
+		return locale
+	}
+
+	if locales := metadata.ValueFromIncomingContext(ctx, MetadataKey); len(locales) > 0 {
+		return locales[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,150 @@
+package locale
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tagPattern accepts the language tags the service stores, e.g. "be" or
+// "en-gb", after Normalize
+var tagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+const MaxTagLength = 35
+
+// Normalize lowercases a language tag and replaces underscores with dashes
+func Normalize(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// Valid reports whether a normalized tag is a language tag
+func Valid(tag string) bool {
+	return len(tag) <= MaxTagLength && tagPattern.MatchString(tag)
+}
+
+// Resolver turns a caller's Accept-Language into the locales to look
+// translations up in. Default is the language of a coach's own name and
+// description, so a chain ends there.
+type Resolver struct {
+	Default   string
+	fallbacks map[string][]string
+}
+
+// NewResolver reads fallbacks as "language:fallback fallback" pairs separated
+// by commas, e.g. "be:ru,uk:ru en", the languages tried after one the coach
+// has no translation for
+func NewResolver(defaultLocale, fallbacks string) (*Resolver, error) {
+	r := &Resolver{Default: Normalize(defaultLocale), fallbacks: map[string][]string{}}
+	if !Valid(r.Default) {
+		return nil, fmt.Errorf("invalid default locale %q", defaultLocale)
+	}
+
+	for _, pair := range strings.Split(fallbacks, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		language, next, ok := strings.Cut(pair, ":")
+		language = Normalize(language)
+		if !ok || !Valid(language) {
+			return nil, fmt.Errorf("invalid locale fallback %q, want language:fallback", pair)
+		}
+
+		for _, fallback := range strings.Fields(next) {
+			fallback = Normalize(fallback)
+			if !Valid(fallback) {
+				return nil, fmt.Errorf("invalid locale fallback %q in %q", fallback, pair)
+			}
+
+			r.fallbacks[language] = append(r.fallbacks[language], fallback)
+		}
+	}
+
+	return r, nil
+}
+
+// Chain returns the locales to try for acceptLanguage, most preferred first.
+// Every tag is followed by its base language and that language's fallbacks.
+// The chain stops at the default locale, which every coach has, and is empty
+// when the caller prefers it or sent nothing.
+func (r *Resolver) Chain(acceptLanguage string) []string {
+	var chain []string
+	seen := map[string]bool{}
+
+	add := func(tag string) bool {
+		if tag == r.Default {
+			return false
+		}
+
+		if !seen[tag] {
+			seen[tag] = true
+			chain = append(chain, tag)
+		}
+
+		return true
+	}
+
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if !add(tag) {
+			return chain
+		}
+
+		language, _, _ := strings.Cut(tag, "-")
+		if !add(language) {
+			return chain
+		}
+
+		for _, fallback := range r.fallbacks[language] {
+			if !add(fallback) {
+				return chain
+			}
+		}
+	}
+
+	return chain
+}
+
+// parseAcceptLanguage returns the valid tags of an Accept-Language value by
+// decreasing quality, dropping wildcards and refused (q=0) tags
+func parseAcceptLanguage(value string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+	for _, part := range strings.Split(value, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = Normalize(tag)
+		if !Valid(tag) {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		if quality > 0 {
+			tags = append(tags, weightedTag{tag: tag, quality: quality})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	ordered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		ordered = append(ordered, tag.tag)
+	}
+
+	return ordered
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"time"
+)
+
+var _ usecase.CloudUseCase = (*CloudUseCase)(nil)
+
+// CloudUseCase records the latency of every storage operation and the bytes
+// uploaded
+type CloudUseCase struct {
+	next usecase.CloudUseCase
+}
+
+func NewCloudUseCase(next usecase.CloudUseCase) *CloudUseCase {
+	return &CloudUseCase{next: next}
+}
+
+func observeStorage(operation string, start time.Time, err error) {
+	StorageSeconds.WithLabelValues(operation, outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+func (c *CloudUseCase) PutObject(ctx context.Context, object []byte, name string) (string, error) {
+	start := time.Now()
+	url, err := c.next.PutObject(ctx, object, name)
+	observeStorage("PutObject", start, err)
+
+	if err == nil {
+		StorageBytes.WithLabelValues("PutObject").Add(float64(len(object)))
+	}
+
+	return url, err
+}
+
+func (c *CloudUseCase) DeleteObject(ctx context.Context, name string) error {
+	start := time.Now()
+	err := c.next.DeleteObject(ctx, name)
+	observeStorage("DeleteObject", start, err)
+
+	return err
+}
+
+func (c *CloudUseCase) ObjectExists(ctx context.Context, name string) (bool, error) {
+	start := time.Now()
+	exists, err := c.next.ObjectExists(ctx, name)
+	observeStorage("ObjectExists", start, err)
+
+	return exists, err
+}
+
+func (c *CloudUseCase) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := c.next.Ping(ctx)
+	observeStorage("Ping", start, err)
+
+	return err
+}
@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ repository.CoachRepository = (*CoachRepository)(nil)
+
+// CoachRepository records the latency and outcome of every query
+type CoachRepository struct {
+	next repository.CoachRepository
+}
+
+func NewCoachRepository(next repository.CoachRepository) *CoachRepository {
+	return &CoachRepository{next: next}
+}
+
+func observeQuery(query string, start time.Time, err error) {
+	QuerySeconds.WithLabelValues(query, outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+func (r *CoachRepository) CreateCoach(ctx context.Context, coach *models.Coach) (*models.Coach, error) {
+	start := time.Now()
+	createdCoach, err := r.next.CreateCoach(ctx, coach)
+	observeQuery("CreateCoach", start, err)
+
+	return createdCoach, err
+}
+
+func (r *CoachRepository) GetCoachById(ctx context.Context, id uuid.UUID) (*models.Coach, error) {
+	start := time.Now()
+	coach, err := r.next.GetCoachById(ctx, id)
+	observeQuery("GetCoachById", start, err)
+
+	return coach, err
+}
+
+func (r *CoachRepository) GetCoachByExternalId(ctx context.Context, externalId string) (*models.Coach, error) {
+	start := time.Now()
+	coach, err := r.next.GetCoachByExternalId(ctx, externalId)
+	observeQuery("GetCoachByExternalId", start, err)
+
+	return coach, err
+}
+
+func (r *CoachRepository) UpdateCoach(ctx context.Context, cmd *dtos.UpdateCoachCommand) error {
+	start := time.Now()
+	err := r.next.UpdateCoach(ctx, cmd)
+	observeQuery("UpdateCoach", start, err)
+
+	return err
+}
+
+func (r *CoachRepository) DeleteCoachById(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	err := r.next.DeleteCoachById(ctx, id)
+	observeQuery("DeleteCoachById", start, err)
+
+	return err
+}
+
+func (r *CoachRepository) GetCoaches(ctx context.Context, filter dtos.CoachesFilter) ([]*models.Coach, error) {
+	start := time.Now()
+	coaches, err := r.next.GetCoaches(ctx, filter)
+	observeQuery("GetCoaches", start, err)
+
+	return coaches, err
+}
+
+func (r *CoachRepository) SetCoachStatus(
+	ctx context.Context,
+	id uuid.UUID,
+	from models.CoachStatus,
+	cmd *dtos.TransitionCoachCommand,
+) error {
+	start := time.Now()
+	err := r.next.SetCoachStatus(ctx, id, from, cmd)
+	observeQuery("SetCoachStatus", start, err)
+
+	return err
+}
+
+func (r *CoachRepository) ScheduleCoach(ctx context.Context, cmd *dtos.ScheduleCoachCommand) error {
+	start := time.Now()
+	err := r.next.ScheduleCoach(ctx, cmd)
+	observeQuery("ScheduleCoach", start, err)
+
+	return err
+}
+
+func (r *CoachRepository) ArchiveExpiredCoaches(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	start := time.Now()
+	ids, err := r.next.ArchiveExpiredCoaches(ctx, now)
+	observeQuery("ArchiveExpiredCoaches", start, err)
+
+	return ids, err
+}
+
+func (r *CoachRepository) GetCoachByUserId(ctx context.Context, userId uuid.UUID) (*models.Coach, error) {
+	start := time.Now()
+	coach, err := r.next.GetCoachByUserId(ctx, userId)
+	observeQuery("GetCoachByUserId", start, err)
+
+	return coach, err
+}
+
+func (r *CoachRepository) SetCoachUser(ctx context.Context, id uuid.UUID, userId *uuid.UUID, updatedTime time.Time) error {
+	start := time.Now()
+	err := r.next.SetCoachUser(ctx, id, userId, updatedTime)
+	observeQuery("SetCoachUser", start, err)
+
+	return err
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ repository.ContactRepository = (*ContactRepository)(nil)
+
+// ContactRepository records the latency and outcome of every query
+type ContactRepository struct {
+	next repository.ContactRepository
+}
+
+func NewContactRepository(next repository.ContactRepository) *ContactRepository {
+	return &ContactRepository{next: next}
+}
+
+func (r *ContactRepository) SetCoachContacts(ctx context.Context, coachId uuid.UUID, contacts []*models.CoachContact) error {
+	start := time.Now()
+	err := r.next.SetCoachContacts(ctx, coachId, contacts)
+	observeQuery("SetCoachContacts", start, err)
+
+	return err
+}
+
+func (r *ContactRepository) GetCoachContacts(ctx context.Context, coachId uuid.UUID) ([]*models.CoachContact, error) {
+	start := time.Now()
+	contacts, err := r.next.GetCoachContacts(ctx, coachId)
+	observeQuery("GetCoachContacts", start, err)
+
+	return contacts, err
+}
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ repository.FavoriteRepository = (*FavoriteRepository)(nil)
+
+// FavoriteRepository records the latency and outcome of every query
+type FavoriteRepository struct {
+	next repository.FavoriteRepository
+}
+
+func NewFavoriteRepository(next repository.FavoriteRepository) *FavoriteRepository {
+	return &FavoriteRepository{next: next}
+}
+
+func (r *FavoriteRepository) AddFavorite(ctx context.Context, favorite *models.CoachFavorite) error {
+	start := time.Now()
+	err := r.next.AddFavorite(ctx, favorite)
+	observeQuery("AddFavorite", start, err)
+
+	return err
+}
+
+func (r *FavoriteRepository) RemoveFavorite(ctx context.Context, userId uuid.UUID, coachId uuid.UUID) error {
+	start := time.Now()
+	err := r.next.RemoveFavorite(ctx, userId, coachId)
+	observeQuery("RemoveFavorite", start, err)
+
+	return err
+}
+
+func (r *FavoriteRepository) GetCoachesFavoriteStats(ctx context.Context, coachIds []uuid.UUID, userId uuid.UUID) ([]*models.CoachFavoriteStats, error) {
+	start := time.Now()
+	stats, err := r.next.GetCoachesFavoriteStats(ctx, coachIds, userId)
+	observeQuery("GetCoachesFavoriteStats", start, err)
+
+	return stats, err
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ repository.LocationRepository = (*LocationRepository)(nil)
+
+// LocationRepository records the latency and outcome of every query
+type LocationRepository struct {
+	next repository.LocationRepository
+}
+
+func NewLocationRepository(next repository.LocationRepository) *LocationRepository {
+	return &LocationRepository{next: next}
+}
+
+func (r *LocationRepository) CreateLocation(ctx context.Context, location *models.Location) (*models.Location, error) {
+	start := time.Now()
+	createdLocation, err := r.next.CreateLocation(ctx, location)
+	observeQuery("CreateLocation", start, err)
+
+	return createdLocation, err
+}
+
+func (r *LocationRepository) GetLocationById(ctx context.Context, id uuid.UUID) (*models.Location, error) {
+	start := time.Now()
+	location, err := r.next.GetLocationById(ctx, id)
+	observeQuery("GetLocationById", start, err)
+
+	return location, err
+}
+
+func (r *LocationRepository) UpdateLocation(ctx context.Context, cmd *dtos.UpdateLocationCommand) error {
+	start := time.Now()
+	err := r.next.UpdateLocation(ctx, cmd)
+	observeQuery("UpdateLocation", start, err)
+
+	return err
+}
+
+func (r *LocationRepository) DeleteLocationById(ctx context.Context, id uuid.UUID) error {
+	start := time.Now()
+	err := r.next.DeleteLocationById(ctx, id)
+	observeQuery("DeleteLocationById", start, err)
+
+	return err
+}
+
+func (r *LocationRepository) GetLocations(ctx context.Context) ([]*models.Location, error) {
+	start := time.Now()
+	locations, err := r.next.GetLocations(ctx)
+	observeQuery("GetLocations", start, err)
+
+	return locations, err
+}
+
+func (r *LocationRepository) SetCoachLocations(ctx context.Context, cmd *dtos.SetCoachLocationsCommand) error {
+	start := time.Now()
+	err := r.next.SetCoachLocations(ctx, cmd)
+	observeQuery("SetCoachLocations", start, err)
+
+	return err
+}
+
+func (r *LocationRepository) GetCoachLocations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachLocation, error) {
+	start := time.Now()
+	coachLocations, err := r.next.GetCoachLocations(ctx, coachId)
+	observeQuery("GetCoachLocations", start, err)
+
+	return coachLocations, err
+}
+
+func (r *LocationRepository) GetCoachLocation(ctx context.Context, coachId, locationId uuid.UUID) (*models.CoachLocation, error) {
+	start := time.Now()
+	coachLocation, err := r.next.GetCoachLocation(ctx, coachId, locationId)
+	observeQuery("GetCoachLocation", start, err)
+
+	return coachLocation, err
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+const namespace = "coach"
+
+var (
+	ServerHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handled_total",
+		Help:      "RPCs completed by the server, by method and status code.",
+	}, []string{"method", "code"})
+	ServerHandlingSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handling_seconds",
+		Help:      "Server RPC latency, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	ServerPanics = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_panics_total",
+		Help:      "Handler panics recovered by the server, by method.",
+	}, []string{"method"})
+
+	ClientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_client_handled_total",
+		Help:      "Downstream RPCs completed, by method and status code.",
+	}, []string{"method", "code"})
+	ClientHandlingSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_client_handling_seconds",
+		Help:      "Downstream RPC latency, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	QuerySeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_seconds",
+		Help:      "Repository query latency, by query and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"query", "status"})
+
+	StorageSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "storage_operation_seconds",
+		Help:      "Object storage latency, by operation and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "status"})
+	StorageBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "storage_bytes_total",
+		Help:      "Bytes sent to object storage, by operation.",
+	}, []string{"operation"})
+
+	CoachesCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "coaches_created_total",
+		Help:      "Coaches created.",
+	})
+	CoachesDeleted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "coaches_deleted_total",
+		Help:      "Coaches deleted.",
+	})
+	CoachTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "coach_status_transitions_total",
+		Help:      "Coach publication status changes, by previous and new status.",
+	}, []string{"from", "to"})
+	PhotosUploaded = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "coach_photos_uploaded_total",
+		Help:      "Coach photos uploaded to object storage.",
+	})
+	ReviewStatsRefreshed = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "review_stats_refreshed_timestamp_seconds",
+		Help:      "Time the review stats of every coach were last refreshed.",
+	})
+)
+
+// RegisterDB exports the sqlx connection pool statistics
+func RegisterDB(db *sqlx.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db.DB, namespace))
+}
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return "error"
+	}
+
+	return "ok"
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ repository.ReviewStatsRepository = (*ReviewStatsRepository)(nil)
+
+// ReviewStatsRepository records the latency and outcome of every query
+type ReviewStatsRepository struct {
+	next repository.ReviewStatsRepository
+}
+
+func NewReviewStatsRepository(next repository.ReviewStatsRepository) *ReviewStatsRepository {
+	return &ReviewStatsRepository{next: next}
+}
+
+func (r *ReviewStatsRepository) SetCoachesReviewStats(ctx context.Context, stats []*models.CoachReviewStats) error {
+	start := time.Now()
+	err := r.next.SetCoachesReviewStats(ctx, stats)
+	observeQuery("SetCoachesReviewStats", start, err)
+
+	return err
+}
+
+func (r *ReviewStatsRepository) GetCoachesReviewStats(ctx context.Context, coachIds []uuid.UUID) ([]*models.CoachReviewStats, error) {
+	start := time.Now()
+	stats, err := r.next.GetCoachesReviewStats(ctx, coachIds)
+	observeQuery("GetCoachesReviewStats", start, err)
+
+	return stats, err
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ repository.TranslationRepository = (*TranslationRepository)(nil)
+
+// TranslationRepository records the latency and outcome of every query
+type TranslationRepository struct {
+	next repository.TranslationRepository
+}
+
+func NewTranslationRepository(next repository.TranslationRepository) *TranslationRepository {
+	return &TranslationRepository{next: next}
+}
+
+func (r *TranslationRepository) SetCoachTranslation(ctx context.Context, translation *models.CoachTranslation) error {
+	start := time.Now()
+	err := r.next.SetCoachTranslation(ctx, translation)
+	observeQuery("SetCoachTranslation", start, err)
+
+	return err
+}
+
+func (r *TranslationRepository) DeleteCoachTranslation(ctx context.Context, coachId uuid.UUID, locale string) error {
+	start := time.Now()
+	err := r.next.DeleteCoachTranslation(ctx, coachId, locale)
+	observeQuery("DeleteCoachTranslation", start, err)
+
+	return err
+}
+
+func (r *TranslationRepository) GetCoachTranslations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachTranslation, error) {
+	start := time.Now()
+	translations, err := r.next.GetCoachTranslations(ctx, coachId)
+	observeQuery("GetCoachTranslations", start, err)
+
+	return translations, err
+}
+
+func (r *TranslationRepository) GetTranslations(ctx context.Context, coachIds []uuid.UUID, locales []string) ([]*models.CoachTranslation, error) {
+	start := time.Now()
+	translations, err := r.next.GetTranslations(ctx, coachIds, locales)
+	observeQuery("GetTranslations", start, err)
+
+	return translations, err
+}
@@ -0,0 +1,99 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// Migration is a pair of sql/<version>_<name>.up.sql and .down.sql files
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// load returns the migrations in the sql directory of fsys, the embedded
+// files in production, ordered by version
+func load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+
+		base, direction, ok := cutDirection(fileName)
+		if !ok {
+			return nil, fmt.Errorf("migration %s: want <version>_<name>.up.sql or .down.sql", fileName)
+		}
+
+		rawVersion, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s: missing name", fileName)
+		}
+
+		version, err := strconv.ParseInt(rawVersion, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version %q", fileName, rawVersion)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join("sql", fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %d is named both %q and %q", version, migration.Name, name)
+		}
+
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func cutDirection(fileName string) (string, string, bool) {
+	if base, ok := strings.CutSuffix(fileName, ".up.sql"); ok {
+		return base, "up", true
+	}
+
+	if base, ok := strings.CutSuffix(fileName, ".down.sql"); ok {
+		return base, "down", true
+	}
+
+	return "", "", false
+}
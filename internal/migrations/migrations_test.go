@@ -0,0 +1,114 @@
+package migrations
+
+import (
+	"io/fs"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	file := func(content string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(content)}
+	}
+
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		versions []int64
+		wantErr  string
+	}{
+		{
+			name: "ordered by version",
+			files: fstest.MapFS{
+				"sql/0010_third.up.sql":   file("third up"),
+				"sql/0002_second.up.sql":  file("second up"),
+				"sql/0001_first.up.sql":   file("first up"),
+				"sql/0001_first.down.sql": file("first down"),
+			},
+			versions: []int64{1, 2, 10},
+		},
+		{
+			name:  "no files",
+			files: fstest.MapFS{"sql": &fstest.MapFile{Mode: fs.ModeDir}},
+		},
+		{
+			name:    "unknown direction",
+			files:   fstest.MapFS{"sql/0001_first.sql": file("")},
+			wantErr: "want <version>_<name>.up.sql or .down.sql",
+		},
+		{
+			name:    "missing name",
+			files:   fstest.MapFS{"sql/0001.up.sql": file("up")},
+			wantErr: "missing name",
+		},
+		{
+			name:    "invalid version",
+			files:   fstest.MapFS{"sql/first_coach.up.sql": file("up")},
+			wantErr: `invalid version "first"`,
+		},
+		{
+			name:    "zero version",
+			files:   fstest.MapFS{"sql/0000_first.up.sql": file("up")},
+			wantErr: `invalid version "0000"`,
+		},
+		{
+			name: "one version with two names",
+			files: fstest.MapFS{
+				"sql/0001_first.up.sql": file("up"),
+				"sql/0001_other.up.sql": file("up"),
+			},
+			wantErr: "migration 1 is named both",
+		},
+		{
+			name:    "down file only",
+			files:   fstest.MapFS{"sql/0001_first.down.sql": file("down")},
+			wantErr: "migration 1_first has no up file",
+		},
+		{
+			name:    "no sql directory",
+			files:   fstest.MapFS{},
+			wantErr: "sql",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			migrations, err := load(test.files)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("load() error = %v, want it to contain %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("load() error = %v", err)
+			}
+
+			versions := make([]int64, 0, len(migrations))
+			for _, migration := range migrations {
+				versions = append(versions, migration.Version)
+			}
+			if !slices.Equal(versions, test.versions) {
+				t.Errorf("load() versions = %v, want %v", versions, test.versions)
+			}
+		})
+	}
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatalf("load() error = %v", err)
+	}
+
+	for i, migration := range migrations {
+		if migration.Version != int64(i+1) {
+			t.Errorf("migration %s has version %d, want %d", migration.Name, migration.Version, i+1)
+		}
+		if migration.Down == "" {
+			t.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// lockKey is the pg_advisory_lock key serializing migrators of this schema,
+// so replicas starting together don't apply the same migration twice
+const lockKey int64 = 0x636f616368 // "coach"
+
+const createVersionTable = `
+CREATE TABLE IF NOT EXISTS "schema_migrations" (
+    version    BIGINT PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []*Migration
+}
+
+func New(db *sqlx.DB) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Status is a known migration and whether it has been applied
+type Status struct {
+	Migration *Migration
+	AppliedAt *time.Time
+}
+
+// Up applies every pending migration in version order, each in its own
+// transaction, and returns how many were applied
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+
+	err := m.withLock(ctx, func(conn *sql.Conn, appliedAt map[int64]time.Time) error {
+		for _, migration := range m.migrations {
+			if _, ok := appliedAt[migration.Version]; ok {
+				continue
+			}
+
+			logger.Info(ctx, "Applying migration", "version", migration.Version, "name", migration.Name)
+
+			if err := m.run(ctx, conn, migration.Up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, `INSERT INTO "schema_migrations" (version, name) VALUES ($1, $2)`, migration.Version, migration.Name)
+				return err
+			}); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			applied++
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns
+// how many were reverted. Reverting 0001 keeps the coach table, which may
+// predate the migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	reverted := 0
+
+	err := m.withLock(ctx, func(conn *sql.Conn, appliedAt map[int64]time.Time) error {
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := appliedAt[migration.Version]; !ok {
+				continue
+			}
+
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+			}
+
+			logger.Info(ctx, "Reverting migration", "version", migration.Version, "name", migration.Name)
+
+			if err := m.run(ctx, conn, migration.Down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, `DELETE FROM "schema_migrations" WHERE version = $1`, migration.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			reverted++
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+
+	err := m.withLock(ctx, func(conn *sql.Conn, appliedAt map[int64]time.Time) error {
+		for _, migration := range m.migrations {
+			status := Status{Migration: migration}
+			if at, ok := appliedAt[migration.Version]; ok {
+				status.AppliedAt = &at
+			}
+
+			statuses = append(statuses, status)
+		}
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// withLock holds the advisory lock on a single connection, which session
+// level locks require, while fn runs
+func (m *Migrator) withLock(
+	ctx context.Context,
+	fn func(conn *sql.Conn, appliedAt map[int64]time.Time) error,
+) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("acquiring migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, lockKey); err != nil {
+			logger.Error(ctx, "Failed to release migration lock", "error", err)
+		}
+	}()
+
+	if _, err = conn.ExecContext(ctx, createVersionTable); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+
+	appliedAt, err := m.applied(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn, appliedAt)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM "schema_migrations"`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	appliedAt := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var at time.Time
+		if err = rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+
+		appliedAt[version] = at
+	}
+
+	return appliedAt, rows.Err()
+}
+
+// run executes a migration script and its version bookkeeping atomically
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, script string, record func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+
+	if err = record(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,3 @@
+-- irreversible: the up migration adopts a coach table that existing
+-- deployments created by hand, so dropping it here would delete their
+-- coaches; reverting this migration only forgets it was applied
@@ -0,0 +1,9 @@
+-- existing deployments created this table by hand, so adopt it if present
+CREATE TABLE IF NOT EXISTS "coach" (
+    id           UUID PRIMARY KEY,
+    name         VARCHAR(100) NOT NULL,
+    description  TEXT         NOT NULL DEFAULT '',
+    photo        TEXT         NOT NULL DEFAULT '',
+    created_time TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_time TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
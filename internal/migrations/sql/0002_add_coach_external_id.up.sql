@@ -0,0 +1,2 @@
+-- external_id identifies coaches of bulk imports, so re-imports update them
+ALTER TABLE "coach" ADD COLUMN external_id TEXT UNIQUE;
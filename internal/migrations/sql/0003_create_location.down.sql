@@ -0,0 +1,2 @@
+DROP TABLE "coach_location";
+DROP TABLE "location";
@@ -0,0 +1,19 @@
+CREATE TABLE "location" (
+    id           UUID PRIMARY KEY,
+    name         VARCHAR(100) NOT NULL UNIQUE,
+    address      TEXT         NOT NULL DEFAULT '',
+    created_time TIMESTAMPTZ  NOT NULL,
+    updated_time TIMESTAMPTZ  NOT NULL
+);
+
+-- a location can't be deleted while coaches are assigned to it, deleting a
+-- coach drops its assignments
+CREATE TABLE "coach_location" (
+    coach_id    UUID    NOT NULL REFERENCES "coach" (id) ON DELETE CASCADE,
+    location_id UUID    NOT NULL REFERENCES "location" (id) ON DELETE RESTRICT,
+    is_primary  BOOLEAN NOT NULL DEFAULT false,
+    PRIMARY KEY (coach_id, location_id)
+);
+
+CREATE UNIQUE INDEX coach_location_primary_idx ON "coach_location" (coach_id) WHERE is_primary;
+CREATE INDEX coach_location_location_id_idx ON "coach_location" (location_id);
@@ -0,0 +1 @@
+DROP TABLE "coach_translation";
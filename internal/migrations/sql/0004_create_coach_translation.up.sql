@@ -0,0 +1,9 @@
+-- the coach row holds the name and description in the default locale
+CREATE TABLE "coach_translation" (
+    coach_id     UUID         NOT NULL REFERENCES "coach" (id) ON DELETE CASCADE,
+    locale       VARCHAR(35)  NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    description  TEXT         NOT NULL DEFAULT '',
+    updated_time TIMESTAMPTZ  NOT NULL,
+    PRIMARY KEY (coach_id, locale)
+);
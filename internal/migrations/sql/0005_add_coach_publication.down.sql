@@ -0,0 +1,5 @@
+ALTER TABLE "coach"
+    DROP CONSTRAINT coach_publication_window,
+    DROP COLUMN unpublish_at,
+    DROP COLUMN publish_at,
+    DROP COLUMN status;
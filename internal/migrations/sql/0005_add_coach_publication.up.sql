@@ -0,0 +1,12 @@
+-- coaches created before publication states existed stay visible, new ones
+-- start as drafts
+ALTER TABLE "coach"
+    ADD COLUMN status       VARCHAR(16) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'in_review', 'published', 'archived')),
+    ADD COLUMN publish_at   TIMESTAMPTZ,
+    ADD COLUMN unpublish_at TIMESTAMPTZ,
+    ADD CONSTRAINT coach_publication_window CHECK (unpublish_at > publish_at);
+
+ALTER TABLE "coach" ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX coach_status_idx ON "coach" (status);
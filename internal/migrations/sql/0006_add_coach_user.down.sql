@@ -0,0 +1 @@
+ALTER TABLE "coach" DROP COLUMN user_id;
@@ -0,0 +1,2 @@
+-- user_id links a coach to their User service account for self-service edits
+ALTER TABLE "coach" ADD COLUMN user_id UUID UNIQUE;
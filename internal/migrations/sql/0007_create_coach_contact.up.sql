@@ -0,0 +1,9 @@
+-- a coach has at most one contact of every kind
+CREATE TABLE "coach_contact" (
+    coach_id     UUID        NOT NULL REFERENCES "coach" (id) ON DELETE CASCADE,
+    kind         VARCHAR(16) NOT NULL,
+    value        TEXT        NOT NULL,
+    visibility   VARCHAR(16) NOT NULL CHECK (visibility IN ('public', 'members', 'staff')),
+    updated_time TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (coach_id, kind)
+);
@@ -0,0 +1,9 @@
+-- refreshed from the Review service, whose reviews carry no rating
+CREATE TABLE "coach_review_stats" (
+    coach_id         UUID        PRIMARY KEY REFERENCES "coach" (id) ON DELETE CASCADE,
+    review_count     INTEGER     NOT NULL,
+    recent_count     INTEGER     NOT NULL,
+    previous_count   INTEGER     NOT NULL,
+    last_review_time TIMESTAMPTZ,
+    refreshed_time   TIMESTAMPTZ NOT NULL
+);
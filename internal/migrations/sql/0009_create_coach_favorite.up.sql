@@ -0,0 +1,9 @@
+-- users are those of the User service, checked when they add a favorite
+CREATE TABLE "coach_favorite" (
+    user_id      UUID        NOT NULL,
+    coach_id     UUID        NOT NULL REFERENCES "coach" (id) ON DELETE CASCADE,
+    created_time TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, coach_id)
+);
+
+CREATE INDEX coach_favorite_coach_id_idx ON "coach_favorite" (coach_id);
@@ -6,10 +6,16 @@ import (
 )
 
 type Coach struct {
-	Id          uuid.UUID `db:"id"`
-	Name        string    `db:"name"`
-	Description string    `db:"description"`
-	Photo       string    `db:"photo"`
-	UpdatedTime time.Time `db:"updated_time"`
-	CreatedTime time.Time `db:"created_time"`
+	Id          uuid.UUID  `db:"id"`
+	Name        string     `db:"name"`
+	Description string     `db:"description"`
+	Photo       string     `db:"photo"`
+	ExternalId  *string    `db:"external_id"`
+	UserId      *uuid.UUID `db:"user_id"`
+	UpdatedTime time.Time  `db:"updated_time"`
+	CreatedTime time.Time  `db:"created_time"`
+
+	Status      CoachStatus `db:"status"`
+	PublishAt   *time.Time  `db:"publish_at"`
+	UnpublishAt *time.Time  `db:"unpublish_at"`
 }
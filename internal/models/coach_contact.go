@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ContactKind string
+
+const (
+	ContactEmail     ContactKind = "email"
+	ContactPhone     ContactKind = "phone"
+	ContactTelegram  ContactKind = "telegram"
+	ContactWhatsApp  ContactKind = "whatsapp"
+	ContactViber     ContactKind = "viber"
+	ContactInstagram ContactKind = "instagram"
+	ContactFacebook  ContactKind = "facebook"
+	ContactVK        ContactKind = "vk"
+	ContactYouTube   ContactKind = "youtube"
+	ContactWebsite   ContactKind = "website"
+)
+
+// ContactVisibility is the audience a contact is shown to; every audience
+// also sees the contacts of the audiences before it
+type ContactVisibility string
+
+const (
+	ContactPublic  ContactVisibility = "public"
+	ContactMembers ContactVisibility = "members"
+	ContactStaff   ContactVisibility = "staff"
+)
+
+var contactAudiences = map[ContactVisibility]int{
+	ContactPublic:  0,
+	ContactMembers: 1,
+	ContactStaff:   2,
+}
+
+// VisibleTo reports whether a contact of visibility v is shown to audience
+func (v ContactVisibility) VisibleTo(audience ContactVisibility) bool {
+	level, ok := contactAudiences[v]
+	return ok && level <= contactAudiences[audience]
+}
+
+type CoachContact struct {
+	CoachId     uuid.UUID         `db:"coach_id"`
+	Kind        ContactKind       `db:"kind"`
+	Value       string            `db:"value"`
+	Visibility  ContactVisibility `db:"visibility"`
+	UpdatedTime time.Time         `db:"updated_time"`
+}
+
+// VisibleContacts returns the contacts shown to audience
+func VisibleContacts(contacts []*CoachContact, audience ContactVisibility) []*CoachContact {
+	var visible []*CoachContact
+	for _, contact := range contacts {
+		if contact.Visibility.VisibleTo(audience) {
+			visible = append(visible, contact)
+		}
+	}
+
+	return visible
+}
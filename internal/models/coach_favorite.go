@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type CoachFavorite struct {
+	UserId      uuid.UUID `db:"user_id"`
+	CoachId     uuid.UUID `db:"coach_id"`
+	CreatedTime time.Time `db:"created_time"`
+}
+
+// CoachFavoriteStats counts the users who favorited a coach; Favorited
+// reports whether the caller is one of them
+type CoachFavoriteStats struct {
+	CoachId       uuid.UUID `db:"coach_id"`
+	FavoriteCount int       `db:"favorite_count"`
+	Favorited     bool      `db:"favorited"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// CoachReviewStats summarizes the reviews of a coach. Reviews carry no
+// rating, so they are counted rather than averaged; RecentCount covers the
+// latest trend window and PreviousCount the one before it.
+type CoachReviewStats struct {
+	CoachId        uuid.UUID  `db:"coach_id"`
+	ReviewCount    int        `db:"review_count"`
+	RecentCount    int        `db:"recent_count"`
+	PreviousCount  int        `db:"previous_count"`
+	LastReviewTime *time.Time `db:"last_review_time"`
+	RefreshedTime  time.Time  `db:"refreshed_time"`
+}
+
+// Trend is positive when a coach is reviewed more often than in the previous
+// window
+func (s *CoachReviewStats) Trend() int {
+	return s.RecentCount - s.PreviousCount
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+)
+
+// CoachStatus is the publication state of a coach profile
+type CoachStatus string
+
+const (
+	CoachDraft     CoachStatus = "draft"
+	CoachInReview  CoachStatus = "in_review"
+	CoachPublished CoachStatus = "published"
+	CoachArchived  CoachStatus = "archived"
+)
+
+// coachTransitions lists the statuses every status may move to: drafts are
+// submitted for review, reviews are approved or sent back, published
+// profiles are archived and archived ones reopened as drafts
+var coachTransitions = map[CoachStatus][]CoachStatus{
+	CoachDraft:     {CoachInReview},
+	CoachInReview:  {CoachDraft, CoachPublished},
+	CoachPublished: {CoachArchived},
+	CoachArchived:  {CoachDraft},
+}
+
+func (s CoachStatus) CanTransitionTo(next CoachStatus) bool {
+	for _, allowed := range coachTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPublic reports whether clients see the coach at now: it must be
+// published and inside its publication window, if scheduled
+func (c *Coach) IsPublic(now time.Time) bool {
+	if c.Status != CoachPublished {
+		return false
+	}
+
+	if c.PublishAt != nil && now.Before(*c.PublishAt) {
+		return false
+	}
+
+	return c.UnpublishAt == nil || now.Before(*c.UnpublishAt)
+}
@@ -0,0 +1,42 @@
+package models
+
+import "testing"
+
+func TestCoachStatusCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from CoachStatus
+		to   CoachStatus
+		want bool
+	}{
+		{CoachDraft, CoachInReview, true},
+		{CoachDraft, CoachPublished, false},
+		{CoachDraft, CoachArchived, false},
+		{CoachDraft, CoachDraft, false},
+
+		{CoachInReview, CoachDraft, true},
+		{CoachInReview, CoachPublished, true},
+		{CoachInReview, CoachArchived, false},
+		{CoachInReview, CoachInReview, false},
+
+		{CoachPublished, CoachArchived, true},
+		{CoachPublished, CoachDraft, false},
+		{CoachPublished, CoachInReview, false},
+		{CoachPublished, CoachPublished, false},
+
+		{CoachArchived, CoachDraft, true},
+		{CoachArchived, CoachInReview, false},
+		{CoachArchived, CoachPublished, false},
+		{CoachArchived, CoachArchived, false},
+
+		{"unknown", CoachDraft, false},
+		{CoachDraft, "unknown", false},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.from)+"->"+string(test.to), func(t *testing.T) {
+			if got := test.from.CanTransitionTo(test.to); got != test.want {
+				t.Errorf("CanTransitionTo() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// CoachTranslation is a coach's name and description in another locale than
+// the default one; an empty Description falls back to the coach's own
+type CoachTranslation struct {
+	CoachId     uuid.UUID `db:"coach_id"`
+	Locale      string    `db:"locale"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	UpdatedTime time.Time `db:"updated_time"`
+}
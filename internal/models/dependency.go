@@ -0,0 +1,36 @@
+package models
+
+import "strings"
+
+type Dependency string
+
+const (
+	ServiceDependency Dependency = "service"
+	ReviewDependency  Dependency = "review"
+	UserDependency    Dependency = "user"
+)
+
+// DependencyPolicy lists the downstream dependencies whose failure fails an
+// aggregated request. Every other dependency only degrades the response.
+type DependencyPolicy struct {
+	Fatal map[Dependency]bool
+}
+
+// ParseDependencyPolicy reads a comma separated list of fatal dependencies,
+// e.g. "service,review".
+func ParseDependencyPolicy(fatal string) *DependencyPolicy {
+	policy := &DependencyPolicy{Fatal: map[Dependency]bool{}}
+
+	for _, dependency := range strings.Split(fatal, ",") {
+		dependency = strings.TrimSpace(dependency)
+		if dependency != "" {
+			policy.Fatal[Dependency(dependency)] = true
+		}
+	}
+
+	return policy
+}
+
+func (p *DependencyPolicy) IsFatal(dependency Dependency) bool {
+	return p.Fatal[dependency]
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Location struct {
+	Id          uuid.UUID `db:"id"`
+	Name        string    `db:"name"`
+	Address     string    `db:"address"`
+	UpdatedTime time.Time `db:"updated_time"`
+	CreatedTime time.Time `db:"created_time"`
+}
+
+// CoachLocation is a location a coach works at; a coach with locations has
+// exactly one primary location
+type CoachLocation struct {
+	Location
+	CoachId uuid.UUID `db:"coach_id"`
+	Primary bool      `db:"is_primary"`
+}
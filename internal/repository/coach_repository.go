@@ -5,13 +5,28 @@ import (
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
 	"github.com/google/uuid"
+	"time"
 )
 
 type CoachRepository interface {
 	CreateCoach(ctx context.Context, coach *models.Coach) (*models.Coach, error)
 	GetCoachById(ctx context.Context, id uuid.UUID) (*models.Coach, error)
+	GetCoachByExternalId(ctx context.Context, externalId string) (*models.Coach, error)
+	GetCoachByUserId(ctx context.Context, userId uuid.UUID) (*models.Coach, error)
 	UpdateCoach(ctx context.Context, cmd *dtos.UpdateCoachCommand) error
 	DeleteCoachById(ctx context.Context, id uuid.UUID) error
 
-	GetCoaches(ctx context.Context) ([]*models.Coach, error)
+	GetCoaches(ctx context.Context, filter dtos.CoachesFilter) ([]*models.Coach, error)
+
+	// SetCoachStatus moves a coach from one status to another; it fails with
+	// errors.InvalidCoachTransition when the coach is no longer in from
+	SetCoachStatus(ctx context.Context, id uuid.UUID, from models.CoachStatus, cmd *dtos.TransitionCoachCommand) error
+	ScheduleCoach(ctx context.Context, cmd *dtos.ScheduleCoachCommand) error
+	// ArchiveExpiredCoaches archives the published coaches whose publication
+	// window closed before now, returning their ids
+	ArchiveExpiredCoaches(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+
+	// SetCoachUser links a coach to a user, or unlinks it for a nil userId;
+	// a user links to one coach at most
+	SetCoachUser(ctx context.Context, id uuid.UUID, userId *uuid.UUID, updatedTime time.Time) error
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type ContactRepository interface {
+	// SetCoachContacts replaces every contact of a coach
+	SetCoachContacts(ctx context.Context, coachId uuid.UUID, contacts []*models.CoachContact) error
+	GetCoachContacts(ctx context.Context, coachId uuid.UUID) ([]*models.CoachContact, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type FavoriteRepository interface {
+	// AddFavorite does nothing when the user already favorited the coach
+	AddFavorite(ctx context.Context, favorite *models.CoachFavorite) error
+	// RemoveFavorite does nothing when the user didn't favorite the coach
+	RemoveFavorite(ctx context.Context, userId uuid.UUID, coachId uuid.UUID) error
+	// GetCoachesFavoriteStats leaves out coaches nobody favorited; Favorited
+	// is set for the favorites of userId
+	GetCoachesFavoriteStats(ctx context.Context, coachIds []uuid.UUID, userId uuid.UUID) ([]*models.CoachFavoriteStats, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type LocationRepository interface {
+	CreateLocation(ctx context.Context, location *models.Location) (*models.Location, error)
+	GetLocationById(ctx context.Context, id uuid.UUID) (*models.Location, error)
+	UpdateLocation(ctx context.Context, cmd *dtos.UpdateLocationCommand) error
+	DeleteLocationById(ctx context.Context, id uuid.UUID) error
+
+	GetLocations(ctx context.Context) ([]*models.Location, error)
+
+	SetCoachLocations(ctx context.Context, cmd *dtos.SetCoachLocationsCommand) error
+	GetCoachLocations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachLocation, error)
+	GetCoachLocation(ctx context.Context, coachId, locationId uuid.UUID) (*models.CoachLocation, error)
+}
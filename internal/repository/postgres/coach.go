@@ -2,15 +2,29 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
 	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+	"time"
 )
 
+// uniqueViolation is the postgres error code of a unique constraint violation
+const uniqueViolation = "23505"
+
+const coachColumns = `id, name, description, photo, external_id, user_id, created_time, updated_time, status, publish_at, unpublish_at`
+
+// publicCoachCondition mirrors models.Coach.IsPublic
+const publicCoachCondition = `status = 'published' AND (publish_at IS NULL OR publish_at <= now()) AND (unpublish_at IS NULL OR unpublish_at > now())`
+
 var _ repository.CoachRepository = (*CoachRepository)(nil)
 
 type CoachRepository struct {
@@ -23,11 +37,17 @@ func NewCoachRepository(db *sqlx.DB) *CoachRepository {
 
 func (coachRep *CoachRepository) CreateCoach(ctx context.Context, coach *models.Coach) (*models.Coach, error) {
 	_, err := coachRep.db.NamedExecContext(ctx, `
-	INSERT INTO "coach" (id, name, description, photo, created_time, updated_time)
-	VALUES (:id, :name, :description, :photo, :created_time, :updated_time)`, *coach)
+	INSERT INTO "coach" (id, name, description, photo, external_id, user_id, created_time, updated_time, status, publish_at, unpublish_at)
+	VALUES (:id, :name, :description, :photo, :external_id, :user_id, :created_time, :updated_time, :status, :publish_at, :unpublish_at)`, *coach)
 	if err != nil {
-		logger.ErrorLogger.Printf("Error CreateCoach: %v", err)
-		return nil, err
+		logger.Error(ctx, "Error CreateCoach", "error", err)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, customErrors.Conflict("coach", coach.Id.String(), customErrors.CoachAlreadyExists)
+		}
+
+		return nil, customErrors.StorageFailure("CreateCoach", err)
 	}
 
 	return coach, nil
@@ -35,10 +55,44 @@ func (coachRep *CoachRepository) CreateCoach(ctx context.Context, coach *models.
 
 func (coachRep *CoachRepository) GetCoachById(ctx context.Context, id uuid.UUID) (*models.Coach, error) {
 	coach := &models.Coach{}
-	err := coachRep.db.GetContext(ctx, coach, `SELECT id, name, description, photo, created_time, updated_time FROM "coach" WHERE id = $1`, id)
+	err := coachRep.db.GetContext(ctx, coach, `SELECT `+coachColumns+` FROM "coach" WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NotFound("coach", id.String(), customErrors.CoachNotFound)
+		}
+
+		logger.Error(ctx, "Error GetCoachById", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachById", err)
+	}
+
+	return coach, nil
+}
+
+func (coachRep *CoachRepository) GetCoachByExternalId(ctx context.Context, externalId string) (*models.Coach, error) {
+	coach := &models.Coach{}
+	err := coachRep.db.GetContext(ctx, coach, `SELECT `+coachColumns+` FROM "coach" WHERE external_id = $1`, externalId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NotFound("coach", externalId, customErrors.CoachNotFound)
+		}
+
+		logger.Error(ctx, "Error GetCoachByExternalId", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachByExternalId", err)
+	}
+
+	return coach, nil
+}
+
+func (coachRep *CoachRepository) GetCoachByUserId(ctx context.Context, userId uuid.UUID) (*models.Coach, error) {
+	coach := &models.Coach{}
+	err := coachRep.db.GetContext(ctx, coach, `SELECT `+coachColumns+` FROM "coach" WHERE user_id = $1`, userId)
 	if err != nil {
-		logger.ErrorLogger.Printf("Error GetCoachById: %v", err)
-		return nil, err
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NotFound("coach", userId.String(), customErrors.CoachNotFound)
+		}
+
+		logger.Error(ctx, "Error GetCoachByUserId", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachByUserId", err)
 	}
 
 	return coach, nil
@@ -59,7 +113,7 @@ func (coachRep *CoachRepository) UpdateCoach(ctx context.Context, cmd *dtos.Upda
 	setFields["updated_time"] = cmd.UpdatedTime
 
 	if len(setFields) == 0 {
-		logger.InfoLogger.Printf("No fields to update for coach Id: %v", cmd.Id)
+		logger.Info(ctx, "No fields to update for coach", "update_coach_id", cmd.Id)
 		return nil
 	}
 
@@ -79,35 +133,159 @@ func (coachRep *CoachRepository) UpdateCoach(ctx context.Context, cmd *dtos.Upda
 	query += fmt.Sprintf(` WHERE id = $%d`, i)
 	params = append(params, cmd.Id)
 
-	_, err := coachRep.db.ExecContext(ctx, query, params...)
+	result, err := coachRep.db.ExecContext(ctx, query, params...)
 	if err != nil {
-		logger.ErrorLogger.Printf("Error UpdateCoach: %v", err)
-		return err
+		logger.Error(ctx, "Error UpdateCoach", "error", err)
+		return customErrors.StorageFailure("UpdateCoach", err)
 	}
 
-	return nil
+	return requireAffected(result, "UpdateCoach", cmd.Id)
 }
 
 func (coachRep *CoachRepository) DeleteCoachById(ctx context.Context, id uuid.UUID) error {
-	_, err := coachRep.db.ExecContext(ctx, `
+	result, err := coachRep.db.ExecContext(ctx, `
 		DELETE FROM "coach"
 		WHERE id = $1`, id)
 	if err != nil {
-		logger.ErrorLogger.Printf("Error DeleteCoach: %v", err)
-		return err
+		logger.Error(ctx, "Error DeleteCoach", "error", err)
+		return customErrors.StorageFailure("DeleteCoachById", err)
 	}
 
-	return nil
+	return requireAffected(result, "DeleteCoachById", id)
 }
 
-func (coachRep *CoachRepository) GetCoaches(ctx context.Context) ([]*models.Coach, error) {
+func (coachRep *CoachRepository) GetCoaches(ctx context.Context, filter dtos.CoachesFilter) ([]*models.Coach, error) {
 	var coaches []*models.Coach
 
-	err := coachRep.db.SelectContext(ctx, &coaches, `SELECT id, name, description, photo, created_time, updated_time FROM "coach"`)
+	var conditions []string
+	var params []interface{}
+	if filter.LocationId != uuid.Nil {
+		params = append(params, filter.LocationId)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM "coach_location" WHERE coach_id = "coach".id AND location_id = $%d)`, len(params)))
+	}
+	if filter.Status != "" {
+		params = append(params, filter.Status)
+		conditions = append(conditions, fmt.Sprintf(`status = $%d`, len(params)))
+	}
+	if filter.PublicOnly {
+		conditions = append(conditions, publicCoachCondition)
+	}
+	if filter.FavoritesOnly {
+		params = append(params, filter.UserId)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM "coach_favorite" WHERE coach_id = "coach".id AND user_id = $%d)`, len(params)))
+	}
+
+	query := `SELECT ` + coachColumns + ` FROM "coach"`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+
+	switch filter.SortBy {
+	case dtos.CoachesByReviewCount:
+		query += ` ORDER BY (SELECT review_count FROM "coach_review_stats" WHERE coach_id = "coach".id) DESC NULLS LAST, name`
+	case dtos.CoachesByReviewTrend:
+		query += ` ORDER BY (SELECT recent_count - previous_count FROM "coach_review_stats" WHERE coach_id = "coach".id) DESC NULLS LAST, name`
+	case dtos.CoachesFavoritesFirst:
+		params = append(params, filter.UserId)
+		query += fmt.Sprintf(` ORDER BY EXISTS (SELECT 1 FROM "coach_favorite" WHERE coach_id = "coach".id AND user_id = $%d) DESC, name`, len(params))
+	}
+
+	err := coachRep.db.SelectContext(ctx, &coaches, query, params...)
 	if err != nil {
-		logger.ErrorLogger.Printf("Error GetCoaches: %v", err)
-		return nil, err
+		logger.Error(ctx, "Error GetCoaches", "error", err)
+		return nil, customErrors.StorageFailure("GetCoaches", err)
 	}
 
 	return coaches, nil
 }
+
+func (coachRep *CoachRepository) SetCoachStatus(
+	ctx context.Context,
+	id uuid.UUID,
+	from models.CoachStatus,
+	cmd *dtos.TransitionCoachCommand,
+) error {
+	result, err := coachRep.db.ExecContext(ctx, `
+		UPDATE "coach" SET status = $1, updated_time = $2
+		WHERE id = $3 AND status = $4`, cmd.Status, cmd.UpdatedTime, id, from)
+	if err != nil {
+		logger.Error(ctx, "Error SetCoachStatus", "error", err)
+		return customErrors.StorageFailure("SetCoachStatus", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.StorageFailure("SetCoachStatus", err)
+	}
+
+	//the coach was checked to exist, so it changed status meanwhile
+	if affected == 0 {
+		return customErrors.FailedPrecondition("coach", id.String(),
+			fmt.Errorf("%w: coach is no longer %s", customErrors.InvalidCoachTransition, from))
+	}
+
+	return nil
+}
+
+func (coachRep *CoachRepository) ScheduleCoach(ctx context.Context, cmd *dtos.ScheduleCoachCommand) error {
+	result, err := coachRep.db.ExecContext(ctx, `
+		UPDATE "coach" SET publish_at = $1, unpublish_at = $2, updated_time = $3
+		WHERE id = $4`, cmd.PublishAt, cmd.UnpublishAt, cmd.UpdatedTime, cmd.CoachId)
+	if err != nil {
+		logger.Error(ctx, "Error ScheduleCoach", "error", err)
+		return customErrors.StorageFailure("ScheduleCoach", err)
+	}
+
+	return requireAffected(result, "ScheduleCoach", cmd.CoachId)
+}
+
+func (coachRep *CoachRepository) ArchiveExpiredCoaches(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := coachRep.db.SelectContext(ctx, &ids, `
+		UPDATE "coach" SET status = 'archived', updated_time = $1
+		WHERE status = 'published' AND unpublish_at <= $1
+		RETURNING id`, now)
+	if err != nil {
+		logger.Error(ctx, "Error ArchiveExpiredCoaches", "error", err)
+		return nil, customErrors.StorageFailure("ArchiveExpiredCoaches", err)
+	}
+
+	return ids, nil
+}
+
+func (coachRep *CoachRepository) SetCoachUser(
+	ctx context.Context,
+	id uuid.UUID,
+	userId *uuid.UUID,
+	updatedTime time.Time,
+) error {
+	result, err := coachRep.db.ExecContext(ctx, `
+		UPDATE "coach" SET user_id = $1, updated_time = $2
+		WHERE id = $3`, userId, updatedTime, id)
+	if err != nil {
+		logger.Error(ctx, "Error SetCoachUser", "error", err)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return customErrors.Conflict("user", userId.String(), customErrors.UserAlreadyLinked)
+		}
+
+		return customErrors.StorageFailure("SetCoachUser", err)
+	}
+
+	return requireAffected(result, "SetCoachUser", id)
+}
+
+// requireAffected reports a missing coach when a statement matched no rows
+func requireAffected(result sql.Result, op string, id uuid.UUID) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.StorageFailure(op, err)
+	}
+
+	if affected == 0 {
+		return customErrors.NotFound("coach", id.String(), customErrors.CoachNotFound)
+	}
+
+	return nil
+}
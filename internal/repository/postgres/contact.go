@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const contactColumns = `coach_id, kind, value, visibility, updated_time`
+
+var _ repository.ContactRepository = (*ContactRepository)(nil)
+
+type ContactRepository struct {
+	db *sqlx.DB
+}
+
+func NewContactRepository(db *sqlx.DB) *ContactRepository {
+	return &ContactRepository{db: db}
+}
+
+func (contactRep *ContactRepository) SetCoachContacts(
+	ctx context.Context,
+	coachId uuid.UUID,
+	contacts []*models.CoachContact,
+) error {
+	tx, err := contactRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Error SetCoachContacts", "error", err)
+		return customErrors.StorageFailure("SetCoachContacts", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM "coach_contact" WHERE coach_id = $1`, coachId); err != nil {
+		logger.Error(ctx, "Error SetCoachContacts", "error", err)
+		return customErrors.StorageFailure("SetCoachContacts", err)
+	}
+
+	for _, contact := range contacts {
+		_, err = tx.NamedExecContext(ctx, `
+		INSERT INTO "coach_contact" (coach_id, kind, value, visibility, updated_time)
+		VALUES (:coach_id, :kind, :value, :visibility, :updated_time)`, *contact)
+		if err != nil {
+			logger.Error(ctx, "Error SetCoachContacts", "error", err)
+
+			//the coach was deleted since the use case checked it
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+				return customErrors.NotFound("coach", coachId.String(), customErrors.CoachNotFound)
+			}
+
+			return customErrors.StorageFailure("SetCoachContacts", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Error SetCoachContacts", "error", err)
+		return customErrors.StorageFailure("SetCoachContacts", err)
+	}
+
+	return nil
+}
+
+func (contactRep *ContactRepository) GetCoachContacts(ctx context.Context, coachId uuid.UUID) ([]*models.CoachContact, error) {
+	var contacts []*models.CoachContact
+
+	err := contactRep.db.SelectContext(ctx, &contacts, `
+	SELECT `+contactColumns+` FROM "coach_contact"
+	WHERE coach_id = $1
+	ORDER BY kind`, coachId)
+	if err != nil {
+		logger.Error(ctx, "Error GetCoachContacts", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachContacts", err)
+	}
+
+	return contacts, nil
+}
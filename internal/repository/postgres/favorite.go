@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var _ repository.FavoriteRepository = (*FavoriteRepository)(nil)
+
+type FavoriteRepository struct {
+	db *sqlx.DB
+}
+
+func NewFavoriteRepository(db *sqlx.DB) *FavoriteRepository {
+	return &FavoriteRepository{db: db}
+}
+
+func (favoriteRep *FavoriteRepository) AddFavorite(ctx context.Context, favorite *models.CoachFavorite) error {
+	_, err := favoriteRep.db.NamedExecContext(ctx, `
+		INSERT INTO "coach_favorite" (user_id, coach_id, created_time)
+		VALUES (:user_id, :coach_id, :created_time)
+		ON CONFLICT (user_id, coach_id) DO NOTHING`, *favorite)
+	if err != nil {
+		logger.Error(ctx, "Error AddFavorite", "error", err)
+
+		//the coach was deleted since the use case checked it
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return customErrors.NotFound("coach", favorite.CoachId.String(), customErrors.CoachNotFound)
+		}
+
+		return customErrors.StorageFailure("AddFavorite", err)
+	}
+
+	return nil
+}
+
+func (favoriteRep *FavoriteRepository) RemoveFavorite(ctx context.Context, userId uuid.UUID, coachId uuid.UUID) error {
+	_, err := favoriteRep.db.ExecContext(ctx, `
+		DELETE FROM "coach_favorite" WHERE user_id = $1 AND coach_id = $2`, userId, coachId)
+	if err != nil {
+		logger.Error(ctx, "Error RemoveFavorite", "error", err)
+		return customErrors.StorageFailure("RemoveFavorite", err)
+	}
+
+	return nil
+}
+
+func (favoriteRep *FavoriteRepository) GetCoachesFavoriteStats(
+	ctx context.Context,
+	coachIds []uuid.UUID,
+	userId uuid.UUID,
+) ([]*models.CoachFavoriteStats, error) {
+	var stats []*models.CoachFavoriteStats
+
+	ids := make([]string, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		ids = append(ids, coachId.String())
+	}
+
+	err := favoriteRep.db.SelectContext(ctx, &stats, `
+		SELECT coach_id, COUNT(*) AS favorite_count, BOOL_OR(user_id = $2) AS favorited
+		FROM "coach_favorite"
+		WHERE coach_id = ANY($1::uuid[])
+		GROUP BY coach_id`, pq.Array(ids), userId)
+	if err != nil {
+		logger.Error(ctx, "Error GetCoachesFavoriteStats", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachesFavoriteStats", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// foreignKeyViolation is the postgres error code of a foreign key violation
+const foreignKeyViolation = "23503"
+
+const locationColumns = `id, name, address, created_time, updated_time`
+
+const coachLocationColumns = `l.id, l.name, l.address, l.created_time, l.updated_time, cl.coach_id, cl.is_primary`
+
+var _ repository.LocationRepository = (*LocationRepository)(nil)
+
+type LocationRepository struct {
+	db *sqlx.DB
+}
+
+func NewLocationRepository(db *sqlx.DB) *LocationRepository {
+	return &LocationRepository{db: db}
+}
+
+func (locationRep *LocationRepository) CreateLocation(ctx context.Context, location *models.Location) (*models.Location, error) {
+	_, err := locationRep.db.NamedExecContext(ctx, `
+	INSERT INTO "location" (id, name, address, created_time, updated_time)
+	VALUES (:id, :name, :address, :created_time, :updated_time)`, *location)
+	if err != nil {
+		logger.Error(ctx, "Error CreateLocation", "error", err)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, customErrors.Conflict("location", location.Name, customErrors.LocationAlreadyExists)
+		}
+
+		return nil, customErrors.StorageFailure("CreateLocation", err)
+	}
+
+	return location, nil
+}
+
+func (locationRep *LocationRepository) GetLocationById(ctx context.Context, id uuid.UUID) (*models.Location, error) {
+	location := &models.Location{}
+	err := locationRep.db.GetContext(ctx, location, `SELECT `+locationColumns+` FROM "location" WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NotFound("location", id.String(), customErrors.LocationNotFound)
+		}
+
+		logger.Error(ctx, "Error GetLocationById", "error", err)
+		return nil, customErrors.StorageFailure("GetLocationById", err)
+	}
+
+	return location, nil
+}
+
+func (locationRep *LocationRepository) UpdateLocation(ctx context.Context, cmd *dtos.UpdateLocationCommand) error {
+	query := `UPDATE "location" SET updated_time = $1`
+	params := []interface{}{cmd.UpdatedTime}
+
+	if cmd.Name != "" {
+		params = append(params, cmd.Name)
+		query += fmt.Sprintf(`, name = $%d`, len(params))
+	}
+	if cmd.Address != "" {
+		params = append(params, cmd.Address)
+		query += fmt.Sprintf(`, address = $%d`, len(params))
+	}
+
+	params = append(params, cmd.Id)
+	query += fmt.Sprintf(` WHERE id = $%d`, len(params))
+
+	result, err := locationRep.db.ExecContext(ctx, query, params...)
+	if err != nil {
+		logger.Error(ctx, "Error UpdateLocation", "error", err)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return customErrors.Conflict("location", cmd.Name, customErrors.LocationAlreadyExists)
+		}
+
+		return customErrors.StorageFailure("UpdateLocation", err)
+	}
+
+	return requireLocationAffected(result, "UpdateLocation", cmd.Id)
+}
+
+func (locationRep *LocationRepository) DeleteLocationById(ctx context.Context, id uuid.UUID) error {
+	result, err := locationRep.db.ExecContext(ctx, `DELETE FROM "location" WHERE id = $1`, id)
+	if err != nil {
+		logger.Error(ctx, "Error DeleteLocationById", "error", err)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return customErrors.FailedPrecondition("location", id.String(), customErrors.LocationInUse)
+		}
+
+		return customErrors.StorageFailure("DeleteLocationById", err)
+	}
+
+	return requireLocationAffected(result, "DeleteLocationById", id)
+}
+
+func (locationRep *LocationRepository) GetLocations(ctx context.Context) ([]*models.Location, error) {
+	var locations []*models.Location
+
+	err := locationRep.db.SelectContext(ctx, &locations, `SELECT `+locationColumns+` FROM "location" ORDER BY name`)
+	if err != nil {
+		logger.Error(ctx, "Error GetLocations", "error", err)
+		return nil, customErrors.StorageFailure("GetLocations", err)
+	}
+
+	return locations, nil
+}
+
+// SetCoachLocations replaces the assignments of a coach in one transaction,
+// so readers never see a coach without its primary location
+func (locationRep *LocationRepository) SetCoachLocations(ctx context.Context, cmd *dtos.SetCoachLocationsCommand) error {
+	tx, err := locationRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Error SetCoachLocations", "error", err)
+		return customErrors.StorageFailure("SetCoachLocations", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM "coach_location" WHERE coach_id = $1`, cmd.CoachId); err != nil {
+		logger.Error(ctx, "Error SetCoachLocations", "error", err)
+		return customErrors.StorageFailure("SetCoachLocations", err)
+	}
+
+	for _, locationId := range cmd.LocationIds {
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO "coach_location" (coach_id, location_id, is_primary)
+		VALUES ($1, $2, $3)`, cmd.CoachId, locationId, locationId == cmd.PrimaryLocationId)
+		if err != nil {
+			logger.Error(ctx, "Error SetCoachLocations", "error", err)
+
+			//the coach or location was deleted since the use case checked them
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+				return customErrors.NotFound("location", locationId.String(), customErrors.LocationNotFound)
+			}
+
+			return customErrors.StorageFailure("SetCoachLocations", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Error SetCoachLocations", "error", err)
+		return customErrors.StorageFailure("SetCoachLocations", err)
+	}
+
+	return nil
+}
+
+func (locationRep *LocationRepository) GetCoachLocations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachLocation, error) {
+	var coachLocations []*models.CoachLocation
+
+	err := locationRep.db.SelectContext(ctx, &coachLocations, `
+	SELECT `+coachLocationColumns+`
+	FROM "coach_location" cl JOIN "location" l ON l.id = cl.location_id
+	WHERE cl.coach_id = $1
+	ORDER BY cl.is_primary DESC, l.name`, coachId)
+	if err != nil {
+		logger.Error(ctx, "Error GetCoachLocations", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachLocations", err)
+	}
+
+	return coachLocations, nil
+}
+
+func (locationRep *LocationRepository) GetCoachLocation(ctx context.Context, coachId, locationId uuid.UUID) (*models.CoachLocation, error) {
+	coachLocation := &models.CoachLocation{}
+
+	err := locationRep.db.GetContext(ctx, coachLocation, `
+	SELECT `+coachLocationColumns+`
+	FROM "coach_location" cl JOIN "location" l ON l.id = cl.location_id
+	WHERE cl.coach_id = $1 AND cl.location_id = $2`, coachId, locationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customErrors.NotFound("coach_location", coachId.String()+"/"+locationId.String(), customErrors.CoachNotAtLocation)
+		}
+
+		logger.Error(ctx, "Error GetCoachLocation", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachLocation", err)
+	}
+
+	return coachLocation, nil
+}
+
+// requireLocationAffected reports a missing location when a statement
+// matched no rows
+func requireLocationAffected(result sql.Result, op string, id uuid.UUID) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.StorageFailure(op, err)
+	}
+
+	if affected == 0 {
+		return customErrors.NotFound("location", id.String(), customErrors.LocationNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const reviewStatsColumns = `coach_id, review_count, recent_count, previous_count, last_review_time, refreshed_time`
+
+var _ repository.ReviewStatsRepository = (*ReviewStatsRepository)(nil)
+
+type ReviewStatsRepository struct {
+	db *sqlx.DB
+}
+
+func NewReviewStatsRepository(db *sqlx.DB) *ReviewStatsRepository {
+	return &ReviewStatsRepository{db: db}
+}
+
+func (statsRep *ReviewStatsRepository) SetCoachesReviewStats(
+	ctx context.Context,
+	stats []*models.CoachReviewStats,
+) error {
+	tx, err := statsRep.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error(ctx, "Error SetCoachesReviewStats", "error", err)
+		return customErrors.StorageFailure("SetCoachesReviewStats", err)
+	}
+	defer tx.Rollback()
+
+	for _, coachStats := range stats {
+		_, err = tx.NamedExecContext(ctx, `
+			INSERT INTO "coach_review_stats" (`+reviewStatsColumns+`)
+			SELECT :coach_id, :review_count, :recent_count, :previous_count, :last_review_time, :refreshed_time
+			WHERE EXISTS (SELECT 1 FROM "coach" WHERE id = :coach_id)
+			ON CONFLICT (coach_id) DO UPDATE SET
+				review_count = EXCLUDED.review_count,
+				recent_count = EXCLUDED.recent_count,
+				previous_count = EXCLUDED.previous_count,
+				last_review_time = EXCLUDED.last_review_time,
+				refreshed_time = EXCLUDED.refreshed_time`, coachStats)
+		if err != nil {
+			logger.Error(ctx, "Error SetCoachesReviewStats", "error", err)
+			return customErrors.StorageFailure("SetCoachesReviewStats", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error(ctx, "Error SetCoachesReviewStats", "error", err)
+		return customErrors.StorageFailure("SetCoachesReviewStats", err)
+	}
+
+	return nil
+}
+
+func (statsRep *ReviewStatsRepository) GetCoachesReviewStats(
+	ctx context.Context,
+	coachIds []uuid.UUID,
+) ([]*models.CoachReviewStats, error) {
+	var stats []*models.CoachReviewStats
+
+	ids := make([]string, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		ids = append(ids, coachId.String())
+	}
+
+	err := statsRep.db.SelectContext(ctx, &stats, `
+		SELECT `+reviewStatsColumns+` FROM "coach_review_stats"
+		WHERE coach_id = ANY($1::uuid[])`, pq.Array(ids))
+	if err != nil {
+		logger.Error(ctx, "Error GetCoachesReviewStats", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachesReviewStats", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const translationColumns = `coach_id, locale, name, description, updated_time`
+
+var _ repository.TranslationRepository = (*TranslationRepository)(nil)
+
+type TranslationRepository struct {
+	db *sqlx.DB
+}
+
+func NewTranslationRepository(db *sqlx.DB) *TranslationRepository {
+	return &TranslationRepository{db: db}
+}
+
+func (translationRep *TranslationRepository) SetCoachTranslation(ctx context.Context, translation *models.CoachTranslation) error {
+	_, err := translationRep.db.NamedExecContext(ctx, `
+	INSERT INTO "coach_translation" (coach_id, locale, name, description, updated_time)
+	VALUES (:coach_id, :locale, :name, :description, :updated_time)
+	ON CONFLICT (coach_id, locale) DO UPDATE
+	SET name = EXCLUDED.name, description = EXCLUDED.description, updated_time = EXCLUDED.updated_time`, *translation)
+	if err != nil {
+		logger.Error(ctx, "Error SetCoachTranslation", "error", err)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return customErrors.NotFound("coach", translation.CoachId.String(), customErrors.CoachNotFound)
+		}
+
+		return customErrors.StorageFailure("SetCoachTranslation", err)
+	}
+
+	return nil
+}
+
+func (translationRep *TranslationRepository) DeleteCoachTranslation(ctx context.Context, coachId uuid.UUID, locale string) error {
+	result, err := translationRep.db.ExecContext(ctx, `
+		DELETE FROM "coach_translation"
+		WHERE coach_id = $1 AND locale = $2`, coachId, locale)
+	if err != nil {
+		logger.Error(ctx, "Error DeleteCoachTranslation", "error", err)
+		return customErrors.StorageFailure("DeleteCoachTranslation", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return customErrors.StorageFailure("DeleteCoachTranslation", err)
+	}
+
+	if affected == 0 {
+		return customErrors.NotFound("coach_translation", coachId.String()+"/"+locale, customErrors.TranslationNotFound)
+	}
+
+	return nil
+}
+
+func (translationRep *TranslationRepository) GetCoachTranslations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachTranslation, error) {
+	var translations []*models.CoachTranslation
+
+	err := translationRep.db.SelectContext(ctx, &translations, `
+	SELECT `+translationColumns+` FROM "coach_translation"
+	WHERE coach_id = $1
+	ORDER BY locale`, coachId)
+	if err != nil {
+		logger.Error(ctx, "Error GetCoachTranslations", "error", err)
+		return nil, customErrors.StorageFailure("GetCoachTranslations", err)
+	}
+
+	return translations, nil
+}
+
+func (translationRep *TranslationRepository) GetTranslations(ctx context.Context, coachIds []uuid.UUID, locales []string) ([]*models.CoachTranslation, error) {
+	var translations []*models.CoachTranslation
+
+	ids := make([]string, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		ids = append(ids, coachId.String())
+	}
+
+	err := translationRep.db.SelectContext(ctx, &translations, `
+	SELECT `+translationColumns+` FROM "coach_translation"
+	WHERE coach_id = ANY($1::uuid[]) AND locale = ANY($2)`, pq.Array(ids), pq.Array(locales))
+	if err != nil {
+		logger.Error(ctx, "Error GetTranslations", "error", err)
+		return nil, customErrors.StorageFailure("GetTranslations", err)
+	}
+
+	return translations, nil
+}
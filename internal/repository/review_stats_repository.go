@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type ReviewStatsRepository interface {
+	// SetCoachesReviewStats stores the stats of every given coach, skipping
+	// coaches deleted meanwhile
+	SetCoachesReviewStats(ctx context.Context, stats []*models.CoachReviewStats) error
+	// GetCoachesReviewStats leaves out coaches whose stats were never refreshed
+	GetCoachesReviewStats(ctx context.Context, coachIds []uuid.UUID) ([]*models.CoachReviewStats, error)
+}
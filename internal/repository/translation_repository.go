@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type TranslationRepository interface {
+	// SetCoachTranslation creates or replaces the translation of its locale
+	SetCoachTranslation(ctx context.Context, translation *models.CoachTranslation) error
+	DeleteCoachTranslation(ctx context.Context, coachId uuid.UUID, locale string) error
+	GetCoachTranslations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachTranslation, error)
+
+	// GetTranslations returns the translations of the given coaches into any
+	// of the given locales
+	GetTranslations(ctx context.Context, coachIds []uuid.UUID, locales []string) ([]*models.CoachTranslation, error)
+}
@@ -2,70 +2,163 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/auth"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/certs"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/config"
 	coachGRPC "github.com/DanKo-code/FitnessCenter-Coach/internal/delivery/grpc"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/health"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/interceptors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/migrations"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository/postgres"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/tracing"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/coach_transfer_usecase"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/coach_usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/contact_usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/favorite_usecase"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/localstack_usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/location_usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/review_stats_usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase/translation_usecase"
 	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
 	reviewGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.review"
 	serviceGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
 	userGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.user"
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	grpcCredentials "google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
+const certReloadInterval = 30 * time.Second
+
+// coachCacheTTL bounds how long a coach link or unlink takes to apply to
+// requests, against a database read per authenticated request
+const coachCacheTTL = 10 * time.Second
+
 type AppGRPC struct {
-	gRPCServer   *grpc.Server
-	coachUseCase usecase.CoachUseCase
-	cloudUseCase usecase.CloudUseCase
+	gRPCServer         *grpc.Server
+	healthChecker      *health.Checker
+	coachUseCase       usecase.CoachUseCase
+	cloudUseCase       usecase.CloudUseCase
+	reviewStatsUseCase usecase.ReviewStatsUseCase
+	metricsServer      *http.Server
+	adminServer        *http.Server
+	certReloaders      []*certs.Reloader
+	listenNetwork      string
+
+	publicationSweepInterval   time.Duration
+	reviewStatsRefreshInterval time.Duration
+
+	shutdownTracing func(context.Context) error
 }
 
-func NewAppGRPC(cloudConfig *models.CloudConfig) (*AppGRPC, error) {
+func NewAppGRPC(cfg *config.Config) (*AppGRPC, error) {
+	ctx := context.Background()
+
+	shutdownTracing, err := tracing.Init(ctx, cfg.Tracing.Exporter, cfg.Tracing.File)
+	if err != nil {
+		logger.Error(ctx, "failed to initialize tracing", "error", err)
+		return nil, err
+	}
+
+	db := initDB(ctx, cfg.DB)
+
+	if cfg.DB.MigrateOnStart {
+		migrator, err := migrations.New(db)
+		if err != nil {
+			logger.Error(ctx, "failed to load migrations", "error", err)
+			return nil, err
+		}
+
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			logger.Error(ctx, "failed to migrate database", "error", err)
+			return nil, err
+		}
+		logger.Info(ctx, "Database migrated", "applied", applied)
+	}
+
+	metrics.RegisterDB(db)
+
+	repository := metrics.NewCoachRepository(tracing.NewCoachRepository(postgres.NewCoachRepository(db)))
+	locationRepository := metrics.NewLocationRepository(tracing.NewLocationRepository(postgres.NewLocationRepository(db)))
+	translationRepository := metrics.NewTranslationRepository(tracing.NewTranslationRepository(postgres.NewTranslationRepository(db)))
+	contactRepository := metrics.NewContactRepository(tracing.NewContactRepository(postgres.NewContactRepository(db)))
+	reviewStatsRepository := metrics.NewReviewStatsRepository(tracing.NewReviewStatsRepository(postgres.NewReviewStatsRepository(db)))
+	favoriteRepository := metrics.NewFavoriteRepository(tracing.NewFavoriteRepository(postgres.NewFavoriteRepository(db)))
+
+	localeResolver, err := locale.NewResolver(cfg.Locale.Default, cfg.Locale.Fallbacks)
+	if err != nil {
+		logger.Error(ctx, "failed to configure locales", "error", err)
+		return nil, err
+	}
 
-	db := initDB()
+	clientPolicy, err := downstreamClientPolicy(cfg.Downstream)
+	if err != nil {
+		logger.Error(ctx, "failed to configure downstream clients", "error", err)
+		return nil, err
+	}
 
-	repository := postgres.NewCoachRepository(db)
+	var certReloaders []*certs.Reloader
 
-	connService, err := grpc.NewClient(os.Getenv("SERVICE_SERVICE_PORT"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	clientCredentials, clientReloader, err := downstreamCredentials(cfg.Downstream.TLS)
 	if err != nil {
-		logger.ErrorLogger.Printf("failed to connect to Service server: %v", err)
+		logger.Error(ctx, "failed to configure downstream TLS", "error", err)
+		return nil, err
+	}
+	if clientReloader != nil {
+		certReloaders = append(certReloaders, clientReloader)
+	}
+
+	connService, err := dialDownstream("service", cfg.Downstream.ServiceAddr, clientCredentials, clientPolicy)
+	if err != nil {
+		logger.Error(ctx, "failed to connect to Service server", "error", err)
 		return nil, err
 	}
 	serviceClient := serviceGRPC.NewServiceClient(connService)
 
-	connReview, err := grpc.NewClient(os.Getenv("REVIEW_SERVICE_PORT"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connReview, err := dialDownstream("review", cfg.Downstream.ReviewAddr, clientCredentials, clientPolicy)
 	if err != nil {
-		logger.ErrorLogger.Printf("failed to connect to Review server: %v", err)
+		logger.Error(ctx, "failed to connect to Review server", "error", err)
 		return nil, err
 	}
 	reviewClient := reviewGRPC.NewReviewClient(connReview)
 
-	connUser, err := grpc.NewClient(os.Getenv("USER_SERVICE_PORT"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connUser, err := dialDownstream("user", cfg.Downstream.UserAddr, clientCredentials, clientPolicy)
 	if err != nil {
-		logger.ErrorLogger.Printf("failed to connect to User server: %v", err)
+		logger.Error(ctx, "failed to connect to User server", "error", err)
 		return nil, err
 	}
 	userClient := userGRPC.NewUserClient(connUser)
 
-	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(cloudConfig.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cloudConfig.Key, cloudConfig.Secret, "")),
+	cloudConfig := cfg.CloudConfig()
+	awsCfg, err := awsConfig.LoadDefaultConfig(ctx,
+		awsConfig.WithRegion(cloudConfig.Region),
+		awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cloudConfig.Key, cloudConfig.Secret, "")),
 	)
 	if err != nil {
-		logger.FatalLogger.Fatalf("failed loading config, %v", err)
+		logger.Fatal(ctx, "failed loading config", "error", err)
 		return nil, err
 	}
 
@@ -75,33 +168,184 @@ func NewAppGRPC(cloudConfig *models.CloudConfig) (*AppGRPC, error) {
 	})
 
 	localStackUseCase := localstack_usecase.NewLocalstackUseCase(client, cloudConfig)
+	cloudUseCase := metrics.NewCloudUseCase(tracing.NewCloudUseCase(localStackUseCase))
 
-	coachUseCase := user_usecase.NewCoachUseCase(repository, &serviceClient, &reviewClient, &userClient, localStackUseCase)
+	//dependencies missing from the fatal list only degrade the aggregated response
+	dependencyPolicy := models.ParseDependencyPolicy(cfg.Downstream.FatalDependencies)
 
-	gRPCServer := grpc.NewServer()
+	//a zero TTL disables caching, concurrent requests are still coalesced
+	coachUseCase := user_usecase.NewCoachUseCase(repository, &serviceClient, &reviewClient, &userClient, cloudUseCase, dependencyPolicy, cfg.Cache.CoachesTTL, translationRepository, localeResolver)
+	locationUseCase := location_usecase.NewLocationUseCase(locationRepository, repository)
+	translationUseCase := translation_usecase.NewTranslationUseCase(translationRepository, repository, localeResolver)
+	contactUseCase := contact_usecase.NewContactUseCase(contactRepository, repository)
+	reviewStatsUseCase := review_stats_usecase.NewReviewStatsUseCase(reviewStatsRepository, repository, &reviewClient, cfg.ReviewStats.TrendWindow)
+	favoriteUseCase := favorite_usecase.NewFavoriteUseCase(favoriteRepository, repository, &userClient)
+	coachTransferUseCase := coach_transfer_usecase.NewCoachTransferUseCase(coachUseCase, cloudUseCase, &serviceClient, cfg.Import.PhotoFetchTimeout)
 
-	coachGRPC.RegisterCoachServer(gRPCServer, coachUseCase, localStackUseCase, &serviceClient)
+	verifier, err := newTokenVerifier(cfg.Auth)
+	if err != nil {
+		logger.Error(ctx, "failed to configure authentication", "error", err)
+		return nil, err
+	}
+	accessPolicy := coachGRPC.AccessPolicy()
+	resolveCoach := auth.CachedCoachResolver(coachResolver(repository), coachCacheTTL)
+
+	rateLimitPolicy, err := newRateLimitPolicy(cfg.RateLimit)
+	if err != nil {
+		logger.Error(ctx, "failed to configure rate limits", "error", err)
+		return nil, err
+	}
+	rateLimiter := interceptors.NewRateLimiter(rateLimitPolicy)
+
+	transportCredentials, serverReloader, err := serverCredentials(cfg.TLS)
+	if err != nil {
+		logger.Error(ctx, "failed to configure server TLS", "error", err)
+		return nil, err
+	}
+	if serverReloader != nil {
+		certReloaders = append(certReloaders, serverReloader)
+	}
+
+	//request ID and access log wrap everything, so recovered panics and
+	//rejected calls are logged with the request ID; recovery sits inside
+	//metrics so a panic is counted as Internal
+	gRPCServer := grpc.NewServer(
+		grpc.Creds(transportCredentials),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryServerRequestID(),
+			interceptors.UnaryServerAccessLog(),
+			interceptors.UnaryServerMetrics(),
+			interceptors.UnaryServerRecovery(),
+			interceptors.UnaryServerErrors(),
+			interceptors.UnaryServerAuth(verifier),
+			rateLimiter.UnaryServerInterceptor(),
+			interceptors.UnaryServerAuthorize(resolveCoach, accessPolicy),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.StreamServerRequestID(),
+			interceptors.StreamServerAccessLog(),
+			interceptors.StreamServerMetrics(),
+			interceptors.StreamServerRecovery(),
+			interceptors.StreamServerErrors(),
+			interceptors.StreamServerAuth(verifier),
+			rateLimiter.StreamServerInterceptor(),
+			interceptors.StreamServerAuthorize(resolveCoach, accessPolicy),
+		),
+	)
+
+	coachGRPC.RegisterCoachServer(gRPCServer, coachUseCase, cloudUseCase, &serviceClient)
+	coachGRPC.RegisterCoachProfileServer(gRPCServer, coachUseCase, contactUseCase, reviewStatsUseCase, favoriteUseCase)
+	coachGRPC.RegisterCoachPublicationServer(gRPCServer, coachUseCase)
+	coachGRPC.RegisterCoachTransferServer(gRPCServer, coachTransferUseCase)
+	coachGRPC.RegisterCoachLocationServer(gRPCServer, locationUseCase)
+	coachGRPC.RegisterCoachTranslationServer(gRPCServer, translationUseCase)
+	coachGRPC.RegisterCoachContactServer(gRPCServer, contactUseCase)
+	coachGRPC.RegisterCoachReviewStatsServer(gRPCServer, reviewStatsUseCase)
+	coachGRPC.RegisterCoachFavoriteServer(gRPCServer, coachUseCase, favoriteUseCase)
+
+	healthChecker := health.NewChecker(cfg.Health.ProbeInterval, 2*time.Second)
+	healthChecker.AddProbe("postgres", true, db.PingContext)
+	healthChecker.AddProbe("s3", true, localStackUseCase.Ping)
+	healthChecker.AddProbe("service", dependencyPolicy.IsFatal(models.ServiceDependency), health.GRPCProbe(connService))
+	healthChecker.AddProbe("review", dependencyPolicy.IsFatal(models.ReviewDependency), health.GRPCProbe(connReview))
+	healthChecker.AddProbe("user", dependencyPolicy.IsFatal(models.UserDependency), health.GRPCProbe(connUser))
+	healthChecker.Register(gRPCServer)
 
 	return &AppGRPC{
-		gRPCServer:   gRPCServer,
-		coachUseCase: coachUseCase,
-		cloudUseCase: localStackUseCase,
+		gRPCServer:         gRPCServer,
+		healthChecker:      healthChecker,
+		coachUseCase:       coachUseCase,
+		cloudUseCase:       cloudUseCase,
+		reviewStatsUseCase: reviewStatsUseCase,
+		metricsServer:      newMetricsServer(cfg.Metrics.Port),
+		adminServer:        newAdminServer(cfg.Metrics.AdminAddr),
+		certReloaders:      certReloaders,
+		listenNetwork:      cfg.App.Protocol,
+
+		publicationSweepInterval:   cfg.Publication.SweepInterval,
+		reviewStatsRefreshInterval: cfg.ReviewStats.RefreshInterval,
+
+		shutdownTracing: shutdownTracing,
 	}, nil
 }
 
+// sweepPublications archives coaches past their publication window; clients
+// stop seeing them when the window closes, whether or not a sweep ran
+func (app *AppGRPC) sweepPublications(ctx context.Context) {
+	ticker := time.NewTicker(app.publicationSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := app.coachUseCase.ArchiveExpiredCoaches(ctx); err != nil {
+			logger.Error(ctx, "Failed to archive coaches past their publication window", "error", err)
+		}
+	}
+}
+
+// refreshReviewStats recomputes the review stats of every coach at start and
+// then periodically; a failed refresh keeps the previous stats
+func (app *AppGRPC) refreshReviewStats(ctx context.Context) {
+	ticker := time.NewTicker(app.reviewStatsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := app.reviewStatsUseCase.RefreshReviewStats(ctx); err != nil {
+			logger.Error(ctx, "Failed to refresh coach review stats", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (app *AppGRPC) Run(port string) error {
+	ctx := context.Background()
 
-	listen, err := net.Listen(os.Getenv("APP_GRPC_PROTOCOL"), port)
+	listen, err := net.Listen(app.listenNetwork, port)
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to listen: %v", err)
+		logger.Error(ctx, "Failed to listen", "error", err)
 		return err
 	}
 
-	logger.InfoLogger.Printf("Starting gRPC server on port %s", port)
+	logger.Info(ctx, "Starting gRPC server", "port", port)
+
+	app.healthChecker.Start()
+
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	for _, reloader := range app.certReloaders {
+		go reloader.Watch(watchCtx, certReloadInterval)
+	}
+	go app.sweepPublications(watchCtx)
+	go app.refreshReviewStats(watchCtx)
+
+	go func() {
+		logger.Info(ctx, "Serving metrics", "addr", app.metricsServer.Addr)
+		if err := app.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "Failed to serve metrics", "error", err)
+		}
+	}()
+
+	go func() {
+		logger.Info(ctx, "Serving admin endpoints", "addr", app.adminServer.Addr)
+		if err := app.adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(ctx, "Failed to serve admin endpoints", "error", err)
+		}
+	}()
 
 	go func() {
 		if err = app.gRPCServer.Serve(listen); err != nil {
-			logger.FatalLogger.Fatalf("Failed to serve: %v", err)
+			logger.Fatal(ctx, "Failed to serve", "error", err)
 		}
 	}()
 
@@ -110,30 +354,196 @@ func (app *AppGRPC) Run(port string) error {
 
 	<-quit
 
-	logger.InfoLogger.Printf("stopping gRPC server %s", port)
+	logger.Info(ctx, "stopping gRPC server", "port", port)
+	app.healthChecker.Shutdown()
 	app.gRPCServer.GracefulStop()
 
+	if err = app.metricsServer.Shutdown(ctx); err != nil {
+		logger.Error(ctx, "Failed to stop metrics server", "error", err)
+	}
+
+	if err = app.adminServer.Shutdown(ctx); err != nil {
+		logger.Error(ctx, "Failed to stop admin server", "error", err)
+	}
+
+	if err = app.shutdownTracing(ctx); err != nil {
+		logger.Error(ctx, "Failed to flush traces", "error", err)
+	}
+
 	return nil
 }
 
-func initDB() *sqlx.DB {
+// dialDownstream connects to a dependency through its own circuit breaker,
+// retrying idempotent calls and bounding every attempt by the policy timeout
+func dialDownstream(
+	name, target string,
+	transportCredentials grpcCredentials.TransportCredentials,
+	policy *interceptors.ClientPolicy,
+) (*grpc.ClientConn, error) {
+	breaker := interceptors.NewCircuitBreaker(name, policy)
 
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_PORT"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-		os.Getenv("DB_SLLMODE"),
+	return grpc.NewClient(
+		target,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(
+			interceptors.UnaryClientMetadata(),
+			interceptors.UnaryClientMetrics(),
+			interceptors.UnaryClientRetry(policy),
+			breaker.UnaryClientInterceptor(),
+			interceptors.UnaryClientDeadline(policy),
+		),
 	)
+}
+
+// serverCredentials serves TLS when a certificate and key are configured, and
+// requires client certificates too when a client CA is configured
+func serverCredentials(cfg config.TLSConfig) (grpcCredentials.TransportCredentials, *certs.Reloader, error) {
+	if cfg.CertFile == "" && cfg.KeyFile == "" && cfg.ClientCAFile == "" {
+		logger.Warn(context.Background(), "tls.cert_file is not set, serving without TLS")
+		return insecure.NewCredentials(), nil, nil
+	}
+
+	reloader, err := certs.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig, err := certs.ServerConfig(reloader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return grpcCredentials.NewTLS(tlsConfig), reloader, nil
+}
+
+// downstreamCredentials dials the Service/Review/User servers over TLS when a
+// CA is configured, presenting the client certificate, if any, for mTLS
+func downstreamCredentials(cfg config.DownstreamTLSConfig) (grpcCredentials.TransportCredentials, *certs.Reloader, error) {
+	if cfg.CertFile == "" && cfg.KeyFile == "" && cfg.CAFile == "" {
+		return insecure.NewCredentials(), nil, nil
+	}
+
+	reloader, err := certs.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.CAFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certs.ClientCredentials(reloader, cfg.ServerName), reloader, nil
+}
+
+// coachResolver finds the coach linked to a user through coach.user_id
+func coachResolver(coachRepo repository.CoachRepository) auth.CoachResolver {
+	return func(ctx context.Context, userId string) (string, error) {
+		id, err := uuid.Parse(userId)
+		if err != nil {
+			return "", nil
+		}
+
+		coach, err := coachRepo.GetCoachByUserId(ctx, id)
+		if err != nil {
+			if errors.Is(err, customErrors.CoachNotFound) {
+				return "", nil
+			}
+
+			return "", err
+		}
+
+		return coach.Id.String(), nil
+	}
+}
+
+// newTokenVerifier loads the token signing keys from the JWKS file or, for a
+// single key, the PEM public key file
+func newTokenVerifier(cfg config.AuthConfig) (*auth.Verifier, error) {
+	var keys auth.KeySet
+	var err error
+
+	switch {
+	case cfg.JWKSFile != "":
+		keys, err = auth.LoadJWKS(cfg.JWKSFile)
+	case cfg.PublicKeyFile != "":
+		keys, err = auth.LoadPEM(cfg.PublicKeyFile)
+	default:
+		err = errors.New("auth.jwks_file or auth.public_key_file must be set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewVerifier(keys, cfg.Issuer, cfg.Audience), nil
+}
+
+// newRateLimitPolicy builds the per client limits, given as "rate:burst"
+func newRateLimitPolicy(cfg config.RateLimitConfig) (*interceptors.RateLimitPolicy, error) {
+	policy := &interceptors.RateLimitPolicy{
+		Limits:             map[interceptors.RPCClass]interceptors.Limit{},
+		Classes:            coachGRPC.RateLimitClasses(),
+		MaxInFlightUploads: cfg.MaxInFlightUploads,
+	}
+
+	for class, value := range map[interceptors.RPCClass]string{
+		interceptors.ReadClass:   cfg.Read,
+		interceptors.WriteClass:  cfg.Write,
+		interceptors.UploadClass: cfg.Upload,
+	} {
+		limit, err := interceptors.ParseLimit(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s rate limit: %w", class, err)
+		}
+
+		policy.Limits[class] = limit
+	}
+
+	return policy, nil
+}
+
+// newMetricsServer serves the Prometheus metrics
+func newMetricsServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+// newAdminServer serves the runtime log level; it has no authentication of
+// its own, so it listens apart from the scraped metrics
+func newAdminServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/loglevel", logger.LevelHandler())
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+func downstreamClientPolicy(cfg config.DownstreamConfig) (*interceptors.ClientPolicy, error) {
+	policy := interceptors.DefaultClientPolicy()
+	policy.Timeout = cfg.Timeout
+
+	methodTimeouts, err := interceptors.ParseMethodTimeouts(cfg.MethodTimeouts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid downstream method timeouts: %w", err)
+	}
+	policy.MethodTimeouts = methodTimeouts
+
+	return policy, nil
+}
+
+func initDB(ctx context.Context, cfg config.DBConfig) *sqlx.DB {
 
-	db, err := sqlx.Connect(os.Getenv("DB_DRIVER"), dsn)
+	db, err := sqlx.Connect(cfg.Driver, cfg.DSN())
 	if err != nil {
-		logger.FatalLogger.Fatalf("Database connection failed: %s", err)
+		logger.Fatal(ctx, "Database connection failed", "error", err)
 	}
 
-	logger.InfoLogger.Println("Successfully connected to db")
+	logger.Info(ctx, "Successfully connected to db")
 
 	return db
 }
@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ usecase.CloudUseCase = (*CloudUseCase)(nil)
+
+// CloudUseCase wraps every storage operation in a client span
+type CloudUseCase struct {
+	next usecase.CloudUseCase
+}
+
+func NewCloudUseCase(next usecase.CloudUseCase) *CloudUseCase {
+	return &CloudUseCase{next: next}
+}
+
+func startStorage(ctx context.Context, operation string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, "s3."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("rpc.system", "aws-api"), attribute.String("rpc.service", "S3")),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func (c *CloudUseCase) PutObject(ctx context.Context, object []byte, name string) (string, error) {
+	ctx, span := startStorage(ctx, "PutObject", attribute.String("aws.s3.key", name), attribute.Int("aws.s3.size", len(object)))
+	url, err := c.next.PutObject(ctx, object, name)
+	end(span, err)
+
+	return url, err
+}
+
+func (c *CloudUseCase) DeleteObject(ctx context.Context, name string) error {
+	ctx, span := startStorage(ctx, "DeleteObject", attribute.String("aws.s3.key", name))
+	err := c.next.DeleteObject(ctx, name)
+	end(span, err)
+
+	return err
+}
+
+func (c *CloudUseCase) ObjectExists(ctx context.Context, name string) (bool, error) {
+	ctx, span := startStorage(ctx, "HeadObject", attribute.String("aws.s3.key", name))
+	exists, err := c.next.ObjectExists(ctx, name)
+	end(span, err)
+
+	return exists, err
+}
+
+func (c *CloudUseCase) Ping(ctx context.Context) error {
+	ctx, span := startStorage(ctx, "HeadBucket")
+	err := c.next.Ping(ctx)
+	end(span, err)
+
+	return err
+}
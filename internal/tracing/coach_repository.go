@@ -0,0 +1,152 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+var _ repository.CoachRepository = (*CoachRepository)(nil)
+
+// CoachRepository wraps every query in a client span
+type CoachRepository struct {
+	next repository.CoachRepository
+}
+
+func NewCoachRepository(next repository.CoachRepository) *CoachRepository {
+	return &CoachRepository{next: next}
+}
+
+func startQuery(ctx context.Context, query string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, "postgres."+query,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationName(query)),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func (r *CoachRepository) CreateCoach(ctx context.Context, coach *models.Coach) (*models.Coach, error) {
+	ctx, span := startQuery(ctx, "CreateCoach", attribute.String("coach.id", coach.Id.String()))
+	createdCoach, err := r.next.CreateCoach(ctx, coach)
+	end(span, err)
+
+	return createdCoach, err
+}
+
+func (r *CoachRepository) GetCoachById(ctx context.Context, id uuid.UUID) (*models.Coach, error) {
+	ctx, span := startQuery(ctx, "GetCoachById", attribute.String("coach.id", id.String()))
+	coach, err := r.next.GetCoachById(ctx, id)
+	end(span, err)
+
+	return coach, err
+}
+
+func (r *CoachRepository) GetCoachByExternalId(ctx context.Context, externalId string) (*models.Coach, error) {
+	ctx, span := startQuery(ctx, "GetCoachByExternalId", attribute.String("coach.external_id", externalId))
+	coach, err := r.next.GetCoachByExternalId(ctx, externalId)
+	end(span, err)
+
+	return coach, err
+}
+
+func (r *CoachRepository) UpdateCoach(ctx context.Context, cmd *dtos.UpdateCoachCommand) error {
+	ctx, span := startQuery(ctx, "UpdateCoach", attribute.String("coach.id", cmd.Id.String()))
+	err := r.next.UpdateCoach(ctx, cmd)
+	end(span, err)
+
+	return err
+}
+
+func (r *CoachRepository) DeleteCoachById(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startQuery(ctx, "DeleteCoachById", attribute.String("coach.id", id.String()))
+	err := r.next.DeleteCoachById(ctx, id)
+	end(span, err)
+
+	return err
+}
+
+func (r *CoachRepository) GetCoaches(ctx context.Context, filter dtos.CoachesFilter) ([]*models.Coach, error) {
+	var attributes []attribute.KeyValue
+	if filter.LocationId != uuid.Nil {
+		attributes = append(attributes, attribute.String("location.id", filter.LocationId.String()))
+	}
+	if filter.Status != "" {
+		attributes = append(attributes, attribute.String("coach.status", string(filter.Status)))
+	}
+	attributes = append(attributes, attribute.Bool("coach.public_only", filter.PublicOnly))
+	if filter.FavoritesOnly {
+		attributes = append(attributes, attribute.Bool("coach.favorites_only", true))
+	}
+	if filter.SortBy != dtos.CoachesUnsorted {
+		attributes = append(attributes, attribute.String("coach.sort_by", string(filter.SortBy)))
+	}
+
+	ctx, span := startQuery(ctx, "GetCoaches", attributes...)
+	coaches, err := r.next.GetCoaches(ctx, filter)
+	end(span, err)
+
+	return coaches, err
+}
+
+func (r *CoachRepository) SetCoachStatus(
+	ctx context.Context,
+	id uuid.UUID,
+	from models.CoachStatus,
+	cmd *dtos.TransitionCoachCommand,
+) error {
+	ctx, span := startQuery(ctx, "SetCoachStatus",
+		attribute.String("coach.id", id.String()),
+		attribute.String("coach.status.from", string(from)),
+		attribute.String("coach.status.to", string(cmd.Status)),
+	)
+	err := r.next.SetCoachStatus(ctx, id, from, cmd)
+	end(span, err)
+
+	return err
+}
+
+func (r *CoachRepository) ScheduleCoach(ctx context.Context, cmd *dtos.ScheduleCoachCommand) error {
+	ctx, span := startQuery(ctx, "ScheduleCoach", attribute.String("coach.id", cmd.CoachId.String()))
+	err := r.next.ScheduleCoach(ctx, cmd)
+	end(span, err)
+
+	return err
+}
+
+func (r *CoachRepository) ArchiveExpiredCoaches(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	ctx, span := startQuery(ctx, "ArchiveExpiredCoaches")
+	ids, err := r.next.ArchiveExpiredCoaches(ctx, now)
+	if err == nil {
+		span.SetAttributes(attribute.Int("coach.archived", len(ids)))
+	}
+	end(span, err)
+
+	return ids, err
+}
+
+func (r *CoachRepository) GetCoachByUserId(ctx context.Context, userId uuid.UUID) (*models.Coach, error) {
+	ctx, span := startQuery(ctx, "GetCoachByUserId", attribute.String("user.id", userId.String()))
+	coach, err := r.next.GetCoachByUserId(ctx, userId)
+	end(span, err)
+
+	return coach, err
+}
+
+func (r *CoachRepository) SetCoachUser(ctx context.Context, id uuid.UUID, userId *uuid.UUID, updatedTime time.Time) error {
+	attributes := []attribute.KeyValue{attribute.String("coach.id", id.String())}
+	if userId != nil {
+		attributes = append(attributes, attribute.String("user.id", userId.String()))
+	}
+
+	ctx, span := startQuery(ctx, "SetCoachUser", attributes...)
+	err := r.next.SetCoachUser(ctx, id, userId, updatedTime)
+	end(span, err)
+
+	return err
+}
@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ repository.ContactRepository = (*ContactRepository)(nil)
+
+// ContactRepository wraps every query in a client span
+type ContactRepository struct {
+	next repository.ContactRepository
+}
+
+func NewContactRepository(next repository.ContactRepository) *ContactRepository {
+	return &ContactRepository{next: next}
+}
+
+func (r *ContactRepository) SetCoachContacts(ctx context.Context, coachId uuid.UUID, contacts []*models.CoachContact) error {
+	ctx, span := startQuery(ctx, "SetCoachContacts",
+		attribute.String("coach.id", coachId.String()),
+		attribute.Int("coach.contacts", len(contacts)),
+	)
+	err := r.next.SetCoachContacts(ctx, coachId, contacts)
+	end(span, err)
+
+	return err
+}
+
+func (r *ContactRepository) GetCoachContacts(ctx context.Context, coachId uuid.UUID) ([]*models.CoachContact, error) {
+	ctx, span := startQuery(ctx, "GetCoachContacts", attribute.String("coach.id", coachId.String()))
+	contacts, err := r.next.GetCoachContacts(ctx, coachId)
+	end(span, err)
+
+	return contacts, err
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ repository.FavoriteRepository = (*FavoriteRepository)(nil)
+
+// FavoriteRepository wraps every query in a client span
+type FavoriteRepository struct {
+	next repository.FavoriteRepository
+}
+
+func NewFavoriteRepository(next repository.FavoriteRepository) *FavoriteRepository {
+	return &FavoriteRepository{next: next}
+}
+
+func (r *FavoriteRepository) AddFavorite(ctx context.Context, favorite *models.CoachFavorite) error {
+	ctx, span := startQuery(ctx, "AddFavorite", attribute.String("coach.id", favorite.CoachId.String()))
+	err := r.next.AddFavorite(ctx, favorite)
+	end(span, err)
+
+	return err
+}
+
+func (r *FavoriteRepository) RemoveFavorite(ctx context.Context, userId uuid.UUID, coachId uuid.UUID) error {
+	ctx, span := startQuery(ctx, "RemoveFavorite", attribute.String("coach.id", coachId.String()))
+	err := r.next.RemoveFavorite(ctx, userId, coachId)
+	end(span, err)
+
+	return err
+}
+
+func (r *FavoriteRepository) GetCoachesFavoriteStats(ctx context.Context, coachIds []uuid.UUID, userId uuid.UUID) ([]*models.CoachFavoriteStats, error) {
+	ctx, span := startQuery(ctx, "GetCoachesFavoriteStats", attribute.Int("coach.count", len(coachIds)))
+	stats, err := r.next.GetCoachesFavoriteStats(ctx, coachIds, userId)
+	end(span, err)
+
+	return stats, err
+}
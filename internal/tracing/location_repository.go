@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ repository.LocationRepository = (*LocationRepository)(nil)
+
+// LocationRepository wraps every query in a client span
+type LocationRepository struct {
+	next repository.LocationRepository
+}
+
+func NewLocationRepository(next repository.LocationRepository) *LocationRepository {
+	return &LocationRepository{next: next}
+}
+
+func (r *LocationRepository) CreateLocation(ctx context.Context, location *models.Location) (*models.Location, error) {
+	ctx, span := startQuery(ctx, "CreateLocation", attribute.String("location.id", location.Id.String()))
+	createdLocation, err := r.next.CreateLocation(ctx, location)
+	end(span, err)
+
+	return createdLocation, err
+}
+
+func (r *LocationRepository) GetLocationById(ctx context.Context, id uuid.UUID) (*models.Location, error) {
+	ctx, span := startQuery(ctx, "GetLocationById", attribute.String("location.id", id.String()))
+	location, err := r.next.GetLocationById(ctx, id)
+	end(span, err)
+
+	return location, err
+}
+
+func (r *LocationRepository) UpdateLocation(ctx context.Context, cmd *dtos.UpdateLocationCommand) error {
+	ctx, span := startQuery(ctx, "UpdateLocation", attribute.String("location.id", cmd.Id.String()))
+	err := r.next.UpdateLocation(ctx, cmd)
+	end(span, err)
+
+	return err
+}
+
+func (r *LocationRepository) DeleteLocationById(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startQuery(ctx, "DeleteLocationById", attribute.String("location.id", id.String()))
+	err := r.next.DeleteLocationById(ctx, id)
+	end(span, err)
+
+	return err
+}
+
+func (r *LocationRepository) GetLocations(ctx context.Context) ([]*models.Location, error) {
+	ctx, span := startQuery(ctx, "GetLocations")
+	locations, err := r.next.GetLocations(ctx)
+	end(span, err)
+
+	return locations, err
+}
+
+func (r *LocationRepository) SetCoachLocations(ctx context.Context, cmd *dtos.SetCoachLocationsCommand) error {
+	ctx, span := startQuery(ctx, "SetCoachLocations",
+		attribute.String("coach.id", cmd.CoachId.String()),
+		attribute.Int("locations.count", len(cmd.LocationIds)),
+	)
+	err := r.next.SetCoachLocations(ctx, cmd)
+	end(span, err)
+
+	return err
+}
+
+func (r *LocationRepository) GetCoachLocations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachLocation, error) {
+	ctx, span := startQuery(ctx, "GetCoachLocations", attribute.String("coach.id", coachId.String()))
+	coachLocations, err := r.next.GetCoachLocations(ctx, coachId)
+	end(span, err)
+
+	return coachLocations, err
+}
+
+func (r *LocationRepository) GetCoachLocation(ctx context.Context, coachId, locationId uuid.UUID) (*models.CoachLocation, error) {
+	ctx, span := startQuery(ctx, "GetCoachLocation",
+		attribute.String("coach.id", coachId.String()),
+		attribute.String("location.id", locationId.String()),
+	)
+	coachLocation, err := r.next.GetCoachLocation(ctx, coachId, locationId)
+	end(span, err)
+
+	return coachLocation, err
+}
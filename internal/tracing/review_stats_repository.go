@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ repository.ReviewStatsRepository = (*ReviewStatsRepository)(nil)
+
+// ReviewStatsRepository wraps every query in a client span
+type ReviewStatsRepository struct {
+	next repository.ReviewStatsRepository
+}
+
+func NewReviewStatsRepository(next repository.ReviewStatsRepository) *ReviewStatsRepository {
+	return &ReviewStatsRepository{next: next}
+}
+
+func (r *ReviewStatsRepository) SetCoachesReviewStats(ctx context.Context, stats []*models.CoachReviewStats) error {
+	ctx, span := startQuery(ctx, "SetCoachesReviewStats", attribute.Int("coach.count", len(stats)))
+	err := r.next.SetCoachesReviewStats(ctx, stats)
+	end(span, err)
+
+	return err
+}
+
+func (r *ReviewStatsRepository) GetCoachesReviewStats(ctx context.Context, coachIds []uuid.UUID) ([]*models.CoachReviewStats, error) {
+	ctx, span := startQuery(ctx, "GetCoachesReviewStats", attribute.Int("coach.count", len(coachIds)))
+	stats, err := r.next.GetCoachesReviewStats(ctx, coachIds)
+	end(span, err)
+
+	return stats, err
+}
@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"os"
+)
+
+const (
+	serviceName = "coach"
+	tracerName  = "github.com/DanKo-code/FitnessCenter-Coach"
+)
+
+var tracer = otel.Tracer(tracerName)
+
+// Init installs the global tracer provider and W3C trace-context propagator.
+// exporter is one of "otlp" (configured through the standard
+// OTEL_EXPORTER_OTLP_* variables), "stdout", "file" or "none"; "file" appends
+// spans to filePath. The returned function flushes pending spans.
+func Init(ctx context.Context, exporter, filePath string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var spanExporter sdktrace.SpanExporter
+	var closeFile func() error
+
+	switch exporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		otlpExporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		spanExporter = otlpExporter
+	case "stdout":
+		stdoutExporter, err := stdouttrace.New()
+		if err != nil {
+			return nil, err
+		}
+		spanExporter = stdoutExporter
+	case "file":
+		file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		closeFile = file.Close
+
+		fileExporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, err
+		}
+		spanExporter = fileExporter
+	default:
+		return nil, fmt.Errorf("unknown traces exporter %q", exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeFile != nil {
+			if closeErr := closeFile(); err == nil {
+				err = closeErr
+			}
+		}
+
+		return err
+	}, nil
+}
+
+func start(ctx context.Context, name string, attributes ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, attributes...)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ repository.TranslationRepository = (*TranslationRepository)(nil)
+
+// TranslationRepository wraps every query in a client span
+type TranslationRepository struct {
+	next repository.TranslationRepository
+}
+
+func NewTranslationRepository(next repository.TranslationRepository) *TranslationRepository {
+	return &TranslationRepository{next: next}
+}
+
+func (r *TranslationRepository) SetCoachTranslation(ctx context.Context, translation *models.CoachTranslation) error {
+	ctx, span := startQuery(ctx, "SetCoachTranslation",
+		attribute.String("coach.id", translation.CoachId.String()),
+		attribute.String("locale", translation.Locale),
+	)
+	err := r.next.SetCoachTranslation(ctx, translation)
+	end(span, err)
+
+	return err
+}
+
+func (r *TranslationRepository) DeleteCoachTranslation(ctx context.Context, coachId uuid.UUID, locale string) error {
+	ctx, span := startQuery(ctx, "DeleteCoachTranslation",
+		attribute.String("coach.id", coachId.String()),
+		attribute.String("locale", locale),
+	)
+	err := r.next.DeleteCoachTranslation(ctx, coachId, locale)
+	end(span, err)
+
+	return err
+}
+
+func (r *TranslationRepository) GetCoachTranslations(ctx context.Context, coachId uuid.UUID) ([]*models.CoachTranslation, error) {
+	ctx, span := startQuery(ctx, "GetCoachTranslations", attribute.String("coach.id", coachId.String()))
+	translations, err := r.next.GetCoachTranslations(ctx, coachId)
+	end(span, err)
+
+	return translations, err
+}
+
+func (r *TranslationRepository) GetTranslations(ctx context.Context, coachIds []uuid.UUID, locales []string) ([]*models.CoachTranslation, error) {
+	ctx, span := startQuery(ctx, "GetTranslations",
+		attribute.Int("coaches.count", len(coachIds)),
+		attribute.StringSlice("locales", locales),
+	)
+	translations, err := r.next.GetTranslations(ctx, coachIds, locales)
+	end(span, err)
+
+	return translations, err
+}
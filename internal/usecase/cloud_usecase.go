@@ -1,9 +1,35 @@
 package usecase
 
-import "context"
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"strings"
+)
+
+// CoachPhotoPrefix is the object key prefix of coach photos
+const CoachPhotoPrefix = "coach/"
 
 type CloudUseCase interface {
 	PutObject(ctx context.Context, object []byte, name string) (string, error)
 	DeleteObject(ctx context.Context, name string) error
 	ObjectExists(ctx context.Context, name string) (bool, error)
+	Ping(ctx context.Context) error
+}
+
+// DeleteReplacedPhoto removes a coach photo that is no longer used, by its
+// URL; failures only leak the object, so they are logged and not returned
+func DeleteReplacedPhoto(ctx context.Context, cloud CloudUseCase, photoURL string) {
+	if photoURL == "" {
+		return
+	}
+
+	index := strings.Index(photoURL, CoachPhotoPrefix)
+	if index == -1 {
+		logger.Warn(ctx, "Coach photo URL has no coach/ prefix, leaving it in the cloud", "photo", photoURL)
+		return
+	}
+
+	if err := cloud.DeleteObject(ctx, photoURL[index:]); err != nil {
+		logger.Warn(ctx, "Failed to delete replaced coach photo", "photo", photoURL, "error", err)
+	}
 }
@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+)
+
+type CoachTransferUseCase interface {
+	ImportCoach(ctx context.Context, cmd *dtos.ImportCoachCommand) (*dtos.ImportCoachResult, error)
+	ExportCoaches(ctx context.Context) ([]*dtos.CoachRecord, error)
+}
@@ -0,0 +1,269 @@
+package coach_transfer_usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/validation"
+	serviceGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"time"
+)
+
+var _ usecase.CoachTransferUseCase = (*CoachTransferUseCase)(nil)
+
+// CoachTransferUseCase imports coaches through CoachUseCase, so the coaches
+// cache is invalidated as for single writes
+type CoachTransferUseCase struct {
+	coachUseCase  usecase.CoachUseCase
+	cloudUseCase  usecase.CloudUseCase
+	serviceClient *serviceGRPC.ServiceClient
+	photoClient   *http.Client
+}
+
+func NewCoachTransferUseCase(
+	coachUseCase usecase.CoachUseCase,
+	cloudUseCase usecase.CloudUseCase,
+	serviceClient *serviceGRPC.ServiceClient,
+	photoFetchTimeout time.Duration,
+) *CoachTransferUseCase {
+	return &CoachTransferUseCase{
+		coachUseCase:  coachUseCase,
+		cloudUseCase:  cloudUseCase,
+		serviceClient: serviceClient,
+		photoClient:   newPhotoClient(photoFetchTimeout),
+	}
+}
+
+func (c *CoachTransferUseCase) ImportCoach(
+	ctx context.Context,
+	cmd *dtos.ImportCoachCommand,
+) (*dtos.ImportCoachResult, error) {
+	existingCoach, err := c.findByExternalId(ctx, cmd.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	//re-importing an export points a coach at its own photo, keep it as is
+	if existingCoach != nil && cmd.Photo == nil && cmd.PhotoURL == existingCoach.Photo {
+		cmd.PhotoURL = ""
+	}
+
+	//photo references are resolved on dry runs too, so broken ones are reported
+	photo, err := c.resolvePhoto(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.DryRun {
+		if existingCoach != nil {
+			return &dtos.ImportCoachResult{CoachId: existingCoach.Id, Action: dtos.ImportUpdated}, nil
+		}
+
+		return &dtos.ImportCoachResult{Action: dtos.ImportCreated}, nil
+	}
+
+	if existingCoach != nil {
+		return c.updateCoach(ctx, existingCoach, cmd, photo)
+	}
+
+	return c.createCoach(ctx, cmd, photo)
+}
+
+func (c *CoachTransferUseCase) createCoach(
+	ctx context.Context,
+	cmd *dtos.ImportCoachCommand,
+	photo []byte,
+) (*dtos.ImportCoachResult, error) {
+	createCmd := &dtos.CreateCoachCommand{
+		Id:          uuid.New(),
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		ExternalId:  cmd.ExternalId,
+	}
+
+	if photo != nil {
+		photoURL, err := c.cloudUseCase.PutObject(ctx, photo, usecase.CoachPhotoPrefix+createCmd.Id.String())
+		if err != nil {
+			return nil, err
+		}
+		metrics.PhotosUploaded.Inc()
+
+		createCmd.Photo = photoURL
+	}
+
+	coach, err := c.coachUseCase.CreateCoach(ctx, createCmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cmd.CoachServiceIds) > 0 {
+		_, err = (*c.serviceClient).CreateCoachServices(ctx, &serviceGRPC.CreateCoachServicesRequest{
+			CoachService: &serviceGRPC.CoachService{CoachId: coach.Id.String(), ServiceId: cmd.CoachServiceIds},
+		})
+		if err != nil {
+			return nil, customErrors.DependencyFailure(string(models.ServiceDependency), err)
+		}
+	}
+
+	return &dtos.ImportCoachResult{CoachId: coach.Id, Action: dtos.ImportCreated}, nil
+}
+
+// updateCoach keeps the fields and services a record leaves empty
+func (c *CoachTransferUseCase) updateCoach(
+	ctx context.Context,
+	existingCoach *models.Coach,
+	cmd *dtos.ImportCoachCommand,
+	photo []byte,
+) (*dtos.ImportCoachResult, error) {
+	updateCmd := &dtos.UpdateCoachCommand{
+		Id:          existingCoach.Id,
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		UpdatedTime: time.Now(),
+	}
+
+	if photo != nil {
+		photoURL, err := c.cloudUseCase.PutObject(ctx, photo, usecase.CoachPhotoPrefix+uuid.New().String())
+		if err != nil {
+			return nil, err
+		}
+		metrics.PhotosUploaded.Inc()
+
+		updateCmd.Photo = photoURL
+	}
+
+	coach, err := c.coachUseCase.UpdateCoach(ctx, updateCmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if photo != nil {
+		usecase.DeleteReplacedPhoto(ctx, c.cloudUseCase, existingCoach.Photo)
+	}
+
+	if len(cmd.CoachServiceIds) > 0 {
+		_, err = (*c.serviceClient).UpdateCoachServices(ctx, &serviceGRPC.UpdateCoachServicesRequest{
+			CoachService: &serviceGRPC.CoachService{CoachId: coach.Id.String(), ServiceId: cmd.CoachServiceIds},
+		})
+		if err != nil {
+			return nil, customErrors.DependencyFailure(string(models.ServiceDependency), err)
+		}
+	}
+
+	return &dtos.ImportCoachResult{CoachId: coach.Id, Action: dtos.ImportUpdated}, nil
+}
+
+func (c *CoachTransferUseCase) findByExternalId(ctx context.Context, externalId string) (*models.Coach, error) {
+	if externalId == "" {
+		return nil, nil
+	}
+
+	coach, err := c.coachUseCase.GetCoachByExternalId(ctx, externalId)
+	if err != nil {
+		if customErrors.KindOf(err) == customErrors.KindNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return coach, nil
+}
+
+func (c *CoachTransferUseCase) resolvePhoto(ctx context.Context, cmd *dtos.ImportCoachCommand) ([]byte, error) {
+	if cmd.Photo != nil {
+		return cmd.Photo, nil
+	}
+
+	if cmd.PhotoURL == "" {
+		return nil, nil
+	}
+
+	photo, err := c.fetchPhoto(ctx, cmd.PhotoURL)
+	if err != nil {
+		return nil, customErrors.Invalid(customErrors.FieldViolation{
+			Field:       "record.photo_url",
+			Description: "could not be downloaded: " + err.Error(),
+		})
+	}
+
+	return photo, nil
+}
+
+func (c *CoachTransferUseCase) fetchPhoto(ctx context.Context, photoURL string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, photoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.photoClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %s", response.Status)
+	}
+
+	photo, err := io.ReadAll(io.LimitReader(response.Body, validation.MaxPhotoBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(photo) > validation.MaxPhotoBytes {
+		return nil, fmt.Errorf("photo is larger than %d bytes", validation.MaxPhotoBytes)
+	}
+
+	return photo, nil
+}
+
+// ExportCoaches returns every coach with its service ids; unlike the
+// aggregated read, a Service outage fails the export rather than
+// silently dropping the services. Coaches are exported in the default
+// locale whatever the caller prefers, so that a re-import keeps them.
+func (c *CoachTransferUseCase) ExportCoaches(ctx context.Context) ([]*dtos.CoachRecord, error) {
+	coaches, err := c.coachUseCase.GetCoaches(locale.WithLocale(ctx, ""), dtos.CoachesFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(coaches) == 0 {
+		return nil, nil
+	}
+
+	coachIds := make([]string, 0, len(coaches))
+	for _, coach := range coaches {
+		coachIds = append(coachIds, coach.Id.String())
+	}
+
+	servicesResponse, err := (*c.serviceClient).GetCoachesServices(ctx, &serviceGRPC.GetCoachesServicesRequest{CoachIds: coachIds})
+	if err != nil {
+		return nil, customErrors.DependencyFailure(string(models.ServiceDependency), err)
+	}
+
+	coachServiceIds := make(map[string][]string)
+	for _, coachServices := range servicesResponse.CoachIdsWithServices {
+		for _, service := range coachServices.ServiceObjects {
+			coachServiceIds[coachServices.CoachId] = append(coachServiceIds[coachServices.CoachId], service.Id)
+		}
+	}
+
+	records := make([]*dtos.CoachRecord, 0, len(coaches))
+	for _, coach := range coaches {
+		records = append(records, &dtos.CoachRecord{
+			Coach:           coach,
+			CoachServiceIds: coachServiceIds[coach.Id.String()],
+		})
+	}
+
+	return records, nil
+}
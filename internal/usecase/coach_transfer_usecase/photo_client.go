@@ -0,0 +1,72 @@
+package coach_transfer_usecase
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// maxPhotoRedirects bounds the redirects followed for one photo
+const maxPhotoRedirects = 5
+
+var errPhotoDestination = errors.New("photo URL points at a non-public address")
+
+// newPhotoClient fetches imported photos from public addresses only, so an
+// import file can't make the service call its own network. The check runs
+// on the dialed address, after DNS, and so holds across redirects too.
+func newPhotoClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !isPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", errPhotoDestination, addrPort.Addr())
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= maxPhotoRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxPhotoRedirects)
+			}
+
+			if request.URL.Scheme != "http" && request.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to unsupported scheme %q", request.URL.Scheme)
+			}
+
+			return nil
+		},
+	}
+}
+
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not routable
+// on the internet either
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
-	coachGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
 	"github.com/google/uuid"
 )
 
@@ -13,7 +12,22 @@ type CoachUseCase interface {
 	CreateCoach(ctx context.Context, cmd *dtos.CreateCoachCommand) (*models.Coach, error)
 	DeleteCoachById(ctx context.Context, id uuid.UUID) (*models.Coach, error)
 	GetCoachById(ctx context.Context, uuid uuid.UUID) (*models.Coach, error)
+	GetCoachByExternalId(ctx context.Context, externalId string) (*models.Coach, error)
 
-	GetCoaches(ctx context.Context) ([]*models.Coach, error)
-	GetCoachesWithServices(ctx context.Context) (*coachGRPC.GetCoachesWithServicesWithReviewsWithUsersResponse, error)
+	GetCoaches(ctx context.Context, filter dtos.CoachesFilter) ([]*models.Coach, error)
+	GetCoachesWithServices(ctx context.Context, filter dtos.CoachesFilter) (*dtos.CoachesWithServices, error)
+	GetCoachProfile(ctx context.Context, id uuid.UUID, publicOnly bool) (*dtos.CoachProfile, error)
+
+	TransitionCoach(ctx context.Context, cmd *dtos.TransitionCoachCommand) (*models.Coach, error)
+	ScheduleCoach(ctx context.Context, cmd *dtos.ScheduleCoachCommand) (*models.Coach, error)
+	ArchiveExpiredCoaches(ctx context.Context) error
+
+	// LinkCoachUser fails with errors.UserNotFound for users unknown to the
+	// User service
+	LinkCoachUser(ctx context.Context, coachId uuid.UUID, userId uuid.UUID) (*models.Coach, error)
+	UnlinkCoachUser(ctx context.Context, coachId uuid.UUID) (*models.Coach, error)
+	// GetMyCoachProfile and UpdateMyCoachProfile act on the coach linked to
+	// the user, failing with errors.CoachNotFound when there is none
+	GetMyCoachProfile(ctx context.Context, userId uuid.UUID) (*dtos.CoachProfile, error)
+	UpdateMyCoachProfile(ctx context.Context, cmd *dtos.UpdateMyCoachProfileCommand) (*models.Coach, error)
 }
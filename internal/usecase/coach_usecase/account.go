@@ -0,0 +1,98 @@
+package user_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	userGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.user"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// LinkCoachUser links a coach to an existing User service account, which
+// then manages the coach through the My* RPCs
+func (c *CoachUseCase) LinkCoachUser(
+	ctx context.Context,
+	coachId uuid.UUID,
+	userId uuid.UUID,
+) (*models.Coach, error) {
+	_, err := (*c.userClient).GetUserById(ctx, &userGRPC.GetUserByIdRequest{Id: userId.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, customErrors.NotFound("user", userId.String(), customErrors.UserNotFound)
+		}
+
+		return nil, customErrors.DependencyFailure(string(models.UserDependency), err)
+	}
+
+	if err = c.coachRepo.SetCoachUser(ctx, coachId, &userId, time.Now()); err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "Coach linked to user", "user_id", userId)
+
+	return c.coachRepo.GetCoachById(ctx, coachId)
+}
+
+func (c *CoachUseCase) UnlinkCoachUser(ctx context.Context, coachId uuid.UUID) (*models.Coach, error) {
+	if err := c.coachRepo.SetCoachUser(ctx, coachId, nil, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return c.coachRepo.GetCoachById(ctx, coachId)
+}
+
+// GetMyCoachProfile returns the profile of the coach linked to userId,
+// whatever its publication status
+func (c *CoachUseCase) GetMyCoachProfile(ctx context.Context, userId uuid.UUID) (*dtos.CoachProfile, error) {
+	coach, err := c.coachRepo.GetCoachByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.coachProfile(ctx, coach)
+}
+
+func (c *CoachUseCase) UpdateMyCoachProfile(
+	ctx context.Context,
+	cmd *dtos.UpdateMyCoachProfileCommand,
+) (*models.Coach, error) {
+	existingCoach, err := c.coachRepo.GetCoachByUserId(ctx, cmd.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ctx = logger.WithCoachID(ctx, existingCoach.Id.String())
+
+	updateCmd := &dtos.UpdateCoachCommand{
+		Id:          existingCoach.Id,
+		Description: cmd.Description,
+		UpdatedTime: cmd.UpdatedTime,
+	}
+
+	if cmd.Photo != nil {
+		photoURL, err := c.cloudUseCase.PutObject(ctx, cmd.Photo, usecase.CoachPhotoPrefix+uuid.New().String())
+		if err != nil {
+			return nil, err
+		}
+		metrics.PhotosUploaded.Inc()
+
+		updateCmd.Photo = photoURL
+	}
+
+	coach, err := c.UpdateCoach(ctx, updateCmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Photo != nil {
+		usecase.DeleteReplacedPhoto(ctx, c.cloudUseCase, existingCoach.Photo)
+	}
+
+	return coach, nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
 	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
@@ -14,16 +16,27 @@ import (
 	serviceGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.service"
 	userGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.user"
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 	"strings"
+	"sync"
 	"time"
 )
 
+const coachesWithServicesKey = "coaches_with_services"
+
 type CoachUseCase struct {
 	coachRepo     repository.CoachRepository
 	serviceClient *serviceGRPC.ServiceClient
 	reviewClient  *reviewGRPC.ReviewClient
 	userClient    *userGRPC.UserClient
 	cloudUseCase  usecase.CloudUseCase
+
+	dependencyPolicy *models.DependencyPolicy
+	localizer        *localizer
+
+	coachesGroup singleflight.Group
+	coachesCache *coachesCache
 }
 
 func NewCoachUseCase(
@@ -32,13 +45,20 @@ func NewCoachUseCase(
 	reviewClient *reviewGRPC.ReviewClient,
 	userClient *userGRPC.UserClient,
 	cloudUseCase usecase.CloudUseCase,
+	dependencyPolicy *models.DependencyPolicy,
+	coachesCacheTTL time.Duration,
+	translationRepo repository.TranslationRepository,
+	localeResolver *locale.Resolver,
 ) *CoachUseCase {
 	return &CoachUseCase{
-		coachRepo:     coachRepo,
-		serviceClient: serviceClient,
-		reviewClient:  reviewClient,
-		userClient:    userClient,
-		cloudUseCase:  cloudUseCase,
+		coachRepo:        coachRepo,
+		serviceClient:    serviceClient,
+		reviewClient:     reviewClient,
+		userClient:       userClient,
+		cloudUseCase:     cloudUseCase,
+		dependencyPolicy: dependencyPolicy,
+		localizer:        &localizer{translationRepo: translationRepo, resolver: localeResolver},
+		coachesCache:     newCoachesCache(coachesCacheTTL),
 	}
 }
 
@@ -47,6 +67,7 @@ func (c *CoachUseCase) CreateCoach(
 	cmd *dtos.CreateCoachCommand,
 ) (*models.Coach, error) {
 
+	//coaches stay hidden from clients until their profile is published
 	coach := &models.Coach{
 		Id:          cmd.Id,
 		Name:        cmd.Name,
@@ -54,6 +75,10 @@ func (c *CoachUseCase) CreateCoach(
 		Photo:       cmd.Photo,
 		UpdatedTime: time.Now(),
 		CreatedTime: time.Now(),
+		Status:      models.CoachDraft,
+	}
+	if cmd.ExternalId != "" {
+		coach.ExternalId = &cmd.ExternalId
 	}
 
 	createdCoach, err := c.coachRepo.CreateCoach(ctx, coach)
@@ -61,6 +86,9 @@ func (c *CoachUseCase) CreateCoach(
 		return nil, err
 	}
 
+	metrics.CoachesCreated.Inc()
+	c.invalidateCoaches()
+
 	return createdCoach, nil
 }
 
@@ -73,9 +101,18 @@ func (c *CoachUseCase) GetCoachById(
 		return nil, err
 	}
 
+	c.localizer.localizeCoaches(ctx, []*models.Coach{coach})
+
 	return coach, nil
 }
 
+func (c *CoachUseCase) GetCoachByExternalId(
+	ctx context.Context,
+	externalId string,
+) (*models.Coach, error) {
+	return c.coachRepo.GetCoachByExternalId(ctx, externalId)
+}
+
 func (c *CoachUseCase) UpdateCoach(
 	ctx context.Context,
 	cmd *dtos.UpdateCoachCommand,
@@ -86,6 +123,8 @@ func (c *CoachUseCase) UpdateCoach(
 		return nil, err
 	}
 
+	c.invalidateCoaches()
+
 	coach, err := c.coachRepo.GetCoachById(ctx, cmd.Id)
 	if err != nil {
 		return nil, err
@@ -100,7 +139,7 @@ func (c *CoachUseCase) DeleteCoachById(
 ) (*models.Coach, error) {
 	coach, err := c.coachRepo.GetCoachById(ctx, id)
 	if err != nil {
-		return nil, customErrors.CoachNotFound
+		return nil, err
 	}
 
 	err = c.coachRepo.DeleteCoachById(ctx, id)
@@ -108,6 +147,9 @@ func (c *CoachUseCase) DeleteCoachById(
 		return nil, err
 	}
 
+	metrics.CoachesDeleted.Inc()
+	c.invalidateCoaches()
+
 	if coach.Photo != "" {
 		prefix := "coach/"
 		index := strings.Index(coach.Photo, prefix)
@@ -115,107 +157,204 @@ func (c *CoachUseCase) DeleteCoachById(
 		if index != -1 {
 			s3PhotoKey = coach.Photo[index+len(prefix):]
 		} else {
-			logger.ErrorLogger.Printf("Prefix not found")
-			return nil, fmt.Errorf("prefix not found")
+			//the coach is already gone, so an unknown photo is only leaked, not fatal
+			logger.Warn(ctx, "Coach photo URL has no coach/ prefix, leaving it in the cloud", "photo", coach.Photo)
+			return coach, nil
 		}
 		err = c.cloudUseCase.DeleteObject(ctx, "coach/"+s3PhotoKey)
 		if err != nil {
 			return nil, err
 		}
 	}
-	
+
 	return coach, nil
 }
 
 func (c *CoachUseCase) GetCoaches(
 	ctx context.Context,
+	filter dtos.CoachesFilter,
 ) ([]*models.Coach, error) {
 
-	coaches, err := c.coachRepo.GetCoaches(ctx)
+	coaches, err := c.coachRepo.GetCoaches(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	c.localizer.localizeCoaches(ctx, coaches)
+
 	return coaches, nil
 }
 
 func (c *CoachUseCase) GetCoachesWithServices(
 	ctx context.Context,
-) (*coachGRPC.GetCoachesWithServicesWithReviewsWithUsersResponse, error) {
-	coaches, err := c.coachRepo.GetCoaches(ctx)
+	filter dtos.CoachesFilter,
+) (*dtos.CoachesWithServices, error) {
+	coachesWithServices, err := c.sharedCoachesWithServices(ctx, filter)
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed GetCoaches: %s", err)
 		return nil, err
 	}
 
-	//get coaches ids
-	var coachesIds []string
-	for _, coach := range coaches {
-		coachesIds = append(coachesIds, coach.Id.String())
+	//the shared response is in the default locale, translate a copy
+	localized := c.localizer.localizeCoachObjects(ctx, coachesWithServices.Response.CoachWithServicesWithReviewsWithUsers)
+
+	return &dtos.CoachesWithServices{
+		Response: &coachGRPC.GetCoachesWithServicesWithReviewsWithUsersResponse{
+			CoachWithServicesWithReviewsWithUsers: localized,
+		},
+		UnavailableSections: coachesWithServices.UnavailableSections,
+	}, nil
+}
+
+// sharedCoachesWithServices caches only the unsorted listing of every public
+// coach, whose invalidation depends neither on location assignments nor on
+// review stats and favorites
+func (c *CoachUseCase) sharedCoachesWithServices(
+	ctx context.Context,
+	filter dtos.CoachesFilter,
+) (*dtos.CoachesWithServices, error) {
+	filtered := filter != dtos.CoachesFilter{PublicOnly: true}
+
+	key := coachesWithServicesKey
+	if filtered {
+		key += fmt.Sprintf(":%s:%s:%t:%s:%t:%s",
+			filter.LocationId, filter.Status, filter.PublicOnly, filter.UserId, filter.FavoritesOnly, filter.SortBy)
+	} else if cached := c.coachesCache.get(); cached != nil {
+		return cached, nil
 	}
 
-	//get CoachesIdsWithServices
-	getCoachesServicesRequest := &serviceGRPC.GetCoachesServicesRequest{
-		CoachIds: coachesIds,
+	//identical concurrent requests share one fan-out, which must outlive any single caller
+	generation := c.coachesCache.currentGeneration()
+	resultCh := c.coachesGroup.DoChan(key, func() (interface{}, error) {
+		response, err := c.getCoachesWithServices(context.WithoutCancel(ctx), filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if !filtered {
+			c.coachesCache.store(generation, response)
+		}
+
+		return response, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-resultCh:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		return result.Val.(*dtos.CoachesWithServices), nil
 	}
-	getCoachesServicesResponse, err := (*c.serviceClient).GetCoachesServices(ctx, getCoachesServicesRequest)
+}
+
+func (c *CoachUseCase) getCoachesWithServices(
+	ctx context.Context,
+	filter dtos.CoachesFilter,
+) (*dtos.CoachesWithServices, error) {
+	coaches, err := c.coachRepo.GetCoaches(ctx, filter)
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed GetCoachesServices: %s", err)
+		logger.Error(ctx, "Failed GetCoaches", "error", err)
 		return nil, err
 	}
-	coachIdServices := make(map[string][]*serviceGRPC.ServiceObject)
-	for _, i2 := range getCoachesServicesResponse.CoachIdsWithServices {
-		coachIdServices[i2.CoachId] = append(coachIdServices[i2.CoachId], i2.ServiceObjects...)
-	}
 
-	//get CoachesIdsWithReviews
-	getCoachesReviewsRequest := &reviewGRPC.GetCoachesReviewsRequest{
-		CoachesIds: coachesIds,
-	}
-	GetCoachesReviewsResponse, err := (*c.reviewClient).GetCoachesReviews(ctx, getCoachesReviewsRequest)
+	coachesWithServices, unavailableSections, err := c.assembleCoaches(ctx, coaches)
 	if err != nil {
 		return nil, err
 	}
-	coachIdReviews := make(map[string][]*reviewGRPC.ReviewObject)
-	for _, i2 := range GetCoachesReviewsResponse.CoachIdWithReviewObject {
-		coachIdReviews[i2.CoachId] = append(coachIdReviews[i2.CoachId], i2.ReviewObjects...)
+
+	response := &coachGRPC.GetCoachesWithServicesWithReviewsWithUsersResponse{
+		CoachWithServicesWithReviewsWithUsers: coachesWithServices,
 	}
 
-	//get unique user ids
-	uniqUserIds := make(map[string]struct{})
-	for _, object := range GetCoachesReviewsResponse.CoachIdWithReviewObject {
+	return &dtos.CoachesWithServices{
+		Response:            response,
+		UnavailableSections: unavailableSections,
+	}, nil
+}
 
-		for _, reviewObject := range object.ReviewObjects {
-			if _, ok := uniqUserIds[reviewObject.UserId]; !ok {
-				uniqUserIds[reviewObject.UserId] = struct{}{}
-			}
-		}
-	}
-	var uniqUserIdsSl []string
-	for key, _ := range uniqUserIds {
-		uniqUserIdsSl = append(uniqUserIdsSl, key)
+func (c *CoachUseCase) GetCoachProfile(
+	ctx context.Context,
+	id uuid.UUID,
+	publicOnly bool,
+) (*dtos.CoachProfile, error) {
+	coach, err := usecase.VisibleCoach(ctx, c.coachRepo, id, publicOnly)
+	if err != nil {
+		return nil, err
 	}
 
-	//get userIdsWithUsers
-	getUsersByIdsRequest := &userGRPC.GetUsersByIdsRequest{
-		UsersIds: uniqUserIdsSl,
-	}
-	getUsersByIdsResponse, err := (*c.userClient).GetUsersByIds(ctx, getUsersByIdsRequest)
+	return c.coachProfile(ctx, coach)
+}
+
+func (c *CoachUseCase) coachProfile(ctx context.Context, coach *models.Coach) (*dtos.CoachProfile, error) {
+	c.localizer.localizeCoaches(ctx, []*models.Coach{coach})
+
+	coachesWithServices, unavailableSections, err := c.assembleCoaches(ctx, []*models.Coach{coach})
 	if err != nil {
 		return nil, err
 	}
-	userIdUser := make(map[string]*userGRPC.UserObject)
-	for _, i2 := range getUsersByIdsResponse.UsersObjects {
-		userIdUser[i2.Id] = i2
+
+	return &dtos.CoachProfile{
+		Profile:             coachesWithServices[0],
+		UnavailableSections: unavailableSections,
+	}, nil
+}
+
+// assembleCoaches joins the given coaches with their services, reviews and
+// reviewers, returning the sections left out by degraded dependencies
+func (c *CoachUseCase) assembleCoaches(
+	ctx context.Context,
+	coaches []*models.Coach,
+) ([]*coachGRPC.CoachWithServicesWithReviewsWithUsers, []string, error) {
+	//get coaches ids
+	var coachesIds []string
+	for _, coach := range coaches {
+		coachesIds = append(coachesIds, coach.Id.String())
 	}
 
-	// create method response
-	var coachWithServicesWithReviewsWithUsersSl []*coachGRPC.CoachWithServicesWithReviewsWithUsers
+	//services and reviews (with their users) don't depend on each other, so fetch them concurrently
+	g, gCtx := errgroup.WithContext(ctx)
+	unavailable := &unavailableSections{}
+
+	var coachIdServices map[string][]*serviceGRPC.ServiceObject
+	g.Go(func() error {
+		var servicesErr error
+		coachIdServices, servicesErr = c.getCoachIdServices(gCtx, coachesIds)
+		if servicesErr != nil {
+			unavailable.add(dtos.ServicesSection)
+			return c.degrade(gCtx, models.ServiceDependency, servicesErr)
+		}
 
-	response := &coachGRPC.GetCoachesWithServicesWithReviewsWithUsersResponse{
-		CoachWithServicesWithReviewsWithUsers: nil,
+		return nil
+	})
+
+	var coachIdReviews map[string][]*reviewGRPC.ReviewObject
+	var userIdUser map[string]*userGRPC.UserObject
+	g.Go(func() error {
+		var reviewsErr error
+		coachIdReviews, reviewsErr = c.getCoachIdReviews(gCtx, coachesIds)
+		if reviewsErr != nil {
+			unavailable.add(dtos.ReviewsSection, dtos.UsersSection)
+			return c.degrade(gCtx, models.ReviewDependency, reviewsErr)
+		}
+
+		var usersErr error
+		userIdUser, usersErr = c.getReviewsUsers(gCtx, coachIdReviews)
+		if usersErr != nil {
+			unavailable.add(dtos.UsersSection)
+			return c.degrade(gCtx, models.UserDependency, usersErr)
+		}
+
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
 	}
 
+	var coachWithServicesWithReviewsWithUsersSl []*coachGRPC.CoachWithServicesWithReviewsWithUsers
+
 	for _, coach := range coaches {
 
 		coachObject := &coachGRPC.CoachObject{
@@ -248,7 +387,110 @@ func (c *CoachUseCase) GetCoachesWithServices(
 		coachWithServicesWithReviewsWithUsersSl = append(coachWithServicesWithReviewsWithUsersSl, coachWithServicesWithReviewsWithUsers)
 	}
 
-	response.CoachWithServicesWithReviewsWithUsers = coachWithServicesWithReviewsWithUsersSl
+	return coachWithServicesWithReviewsWithUsersSl, unavailable.sections, nil
+}
+
+// degrade swallows a dependency failure unless the policy marks the
+// dependency as fatal
+func (c *CoachUseCase) degrade(ctx context.Context, dependency models.Dependency, err error) error {
+	if c.dependencyPolicy.IsFatal(dependency) {
+		return customErrors.DependencyFailure(string(dependency), err)
+	}
+
+	logger.Warn(ctx, "Dependency unavailable, degrading response", "dependency", dependency, "error", err)
+
+	return nil
+}
+
+func (c *CoachUseCase) getCoachIdServices(
+	ctx context.Context,
+	coachesIds []string,
+) (map[string][]*serviceGRPC.ServiceObject, error) {
+	getCoachesServicesRequest := &serviceGRPC.GetCoachesServicesRequest{
+		CoachIds: coachesIds,
+	}
+	getCoachesServicesResponse, err := (*c.serviceClient).GetCoachesServices(ctx, getCoachesServicesRequest)
+	if err != nil {
+		logger.Error(ctx, "Failed GetCoachesServices", "error", err)
+		return nil, err
+	}
+
+	coachIdServices := make(map[string][]*serviceGRPC.ServiceObject)
+	for _, i2 := range getCoachesServicesResponse.CoachIdsWithServices {
+		coachIdServices[i2.CoachId] = append(coachIdServices[i2.CoachId], i2.ServiceObjects...)
+	}
+
+	return coachIdServices, nil
+}
+
+func (c *CoachUseCase) getCoachIdReviews(
+	ctx context.Context,
+	coachesIds []string,
+) (map[string][]*reviewGRPC.ReviewObject, error) {
+	getCoachesReviewsRequest := &reviewGRPC.GetCoachesReviewsRequest{
+		CoachesIds: coachesIds,
+	}
+	getCoachesReviewsResponse, err := (*c.reviewClient).GetCoachesReviews(ctx, getCoachesReviewsRequest)
+	if err != nil {
+		logger.Error(ctx, "Failed GetCoachesReviews", "error", err)
+		return nil, err
+	}
+
+	coachIdReviews := make(map[string][]*reviewGRPC.ReviewObject)
+	for _, i2 := range getCoachesReviewsResponse.CoachIdWithReviewObject {
+		coachIdReviews[i2.CoachId] = append(coachIdReviews[i2.CoachId], i2.ReviewObjects...)
+	}
+
+	return coachIdReviews, nil
+}
+
+func (c *CoachUseCase) getReviewsUsers(
+	ctx context.Context,
+	coachIdReviews map[string][]*reviewGRPC.ReviewObject,
+) (map[string]*userGRPC.UserObject, error) {
+	//get unique user ids
+	uniqUserIds := make(map[string]struct{})
+	for _, reviewObjects := range coachIdReviews {
+		for _, reviewObject := range reviewObjects {
+			uniqUserIds[reviewObject.UserId] = struct{}{}
+		}
+	}
+	var uniqUserIdsSl []string
+	for key := range uniqUserIds {
+		uniqUserIdsSl = append(uniqUserIdsSl, key)
+	}
+
+	//get userIdsWithUsers
+	getUsersByIdsRequest := &userGRPC.GetUsersByIdsRequest{
+		UsersIds: uniqUserIdsSl,
+	}
+	getUsersByIdsResponse, err := (*c.userClient).GetUsersByIds(ctx, getUsersByIdsRequest)
+	if err != nil {
+		logger.Error(ctx, "Failed GetUsersByIds", "error", err)
+		return nil, err
+	}
+
+	userIdUser := make(map[string]*userGRPC.UserObject)
+	for _, i2 := range getUsersByIdsResponse.UsersObjects {
+		userIdUser[i2.Id] = i2
+	}
+
+	return userIdUser, nil
+}
+
+func (c *CoachUseCase) invalidateCoaches() {
+	c.coachesCache.invalidate()
+	c.coachesGroup.Forget(coachesWithServicesKey)
+}
+
+type unavailableSections struct {
+	mu       sync.Mutex
+	sections []string
+}
+
+func (u *unavailableSections) add(sections ...string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
 
-	return response, nil
+	u.sections = append(u.sections, sections...)
 }
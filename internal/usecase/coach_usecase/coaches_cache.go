@@ -0,0 +1,66 @@
+package user_usecase
+
+import (
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"sync"
+	"time"
+)
+
+// coachesCache keeps the last complete coaches response for a short TTL;
+// degraded responses are never stored. Every invalidation bumps the
+// generation, so a fan-out started before a write can't store its (possibly
+// stale) result afterwards.
+type coachesCache struct {
+	ttl time.Duration
+
+	mu         sync.RWMutex
+	response   *dtos.CoachesWithServices
+	expiresAt  time.Time
+	generation uint64
+}
+
+func newCoachesCache(ttl time.Duration) *coachesCache {
+	return &coachesCache{ttl: ttl}
+}
+
+func (cc *coachesCache) get() *dtos.CoachesWithServices {
+	cc.mu.RLock()
+	defer cc.mu.RUnlock()
+
+	if cc.response == nil || time.Now().After(cc.expiresAt) {
+		return nil
+	}
+
+	return cc.response
+}
+
+func (cc *coachesCache) currentGeneration() uint64 {
+	cc.mu.RLock()
+	defer cc.mu.RUnlock()
+
+	return cc.generation
+}
+
+func (cc *coachesCache) store(generation uint64, response *dtos.CoachesWithServices) {
+	if cc.ttl <= 0 || response.Degraded() {
+		return
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if generation != cc.generation {
+		return
+	}
+
+	cc.response = response
+	cc.expiresAt = time.Now().Add(cc.ttl)
+}
+
+func (cc *coachesCache) invalidate() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	cc.response = nil
+	cc.generation++
+}
@@ -0,0 +1,116 @@
+package user_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	coachGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"github.com/google/uuid"
+)
+
+// localizer swaps coach names and descriptions for their translation into
+// the caller's preferred locale. Translations are an enhancement, so a
+// failed lookup serves the default locale instead of failing the read.
+type localizer struct {
+	translationRepo repository.TranslationRepository
+	resolver        *locale.Resolver
+}
+
+// translations returns the best translation of every coach that has one
+func (l *localizer) translations(ctx context.Context, coachIds []uuid.UUID) map[uuid.UUID]*models.CoachTranslation {
+	chain := l.resolver.Chain(locale.FromContext(ctx))
+	if len(chain) == 0 || len(coachIds) == 0 {
+		return nil
+	}
+
+	translations, err := l.translationRepo.GetTranslations(ctx, coachIds, chain)
+	if err != nil {
+		logger.Warn(ctx, "Failed to load coach translations, serving the default locale", "error", err)
+		return nil
+	}
+
+	rank := make(map[string]int, len(chain))
+	for i, tag := range chain {
+		rank[tag] = i
+	}
+
+	best := make(map[uuid.UUID]*models.CoachTranslation)
+	for _, translation := range translations {
+		current, ok := best[translation.CoachId]
+		if !ok || rank[translation.Locale] < rank[current.Locale] {
+			best[translation.CoachId] = translation
+		}
+	}
+
+	return best
+}
+
+// localizeCoaches translates coaches in place
+func (l *localizer) localizeCoaches(ctx context.Context, coaches []*models.Coach) {
+	coachIds := make([]uuid.UUID, 0, len(coaches))
+	for _, coach := range coaches {
+		coachIds = append(coachIds, coach.Id)
+	}
+
+	translations := l.translations(ctx, coachIds)
+	for _, coach := range coaches {
+		if translation, ok := translations[coach.Id]; ok {
+			coach.Name = translation.Name
+			if translation.Description != "" {
+				coach.Description = translation.Description
+			}
+		}
+	}
+}
+
+// localizeCoachObjects returns translated copies of shared, possibly cached,
+// coach objects; services and reviews are shared with the originals
+func (l *localizer) localizeCoachObjects(
+	ctx context.Context,
+	coaches []*coachGRPC.CoachWithServicesWithReviewsWithUsers,
+) []*coachGRPC.CoachWithServicesWithReviewsWithUsers {
+	coachIds := make([]uuid.UUID, 0, len(coaches))
+	for _, coach := range coaches {
+		if coachId, err := uuid.Parse(coach.GetCoach().GetId()); err == nil {
+			coachIds = append(coachIds, coachId)
+		}
+	}
+
+	translations := l.translations(ctx, coachIds)
+	if len(translations) == 0 {
+		return coaches
+	}
+
+	localized := make([]*coachGRPC.CoachWithServicesWithReviewsWithUsers, 0, len(coaches))
+	for _, coach := range coaches {
+		coachId, _ := uuid.Parse(coach.GetCoach().GetId())
+
+		translation, ok := translations[coachId]
+		if !ok {
+			localized = append(localized, coach)
+			continue
+		}
+
+		coachObject := &coachGRPC.CoachObject{
+			Id:          coach.Coach.Id,
+			Name:        translation.Name,
+			Description: coach.Coach.Description,
+			Photo:       coach.Coach.Photo,
+			CreatedTime: coach.Coach.CreatedTime,
+			UpdatedTime: coach.Coach.UpdatedTime,
+		}
+		if translation.Description != "" {
+			coachObject.Description = translation.Description
+		}
+
+		localized = append(localized, &coachGRPC.CoachWithServicesWithReviewsWithUsers{
+			Coach:          coachObject,
+			Services:       coach.Services,
+			ReviewWithUser: coach.ReviewWithUser,
+		})
+	}
+
+	return localized
+}
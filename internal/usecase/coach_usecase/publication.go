@@ -0,0 +1,92 @@
+package user_usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	"time"
+)
+
+// TransitionCoach moves a coach along the publication state machine of
+// models.CoachStatus; a profile is only reviewed or published once it has a
+// description and a photo
+func (c *CoachUseCase) TransitionCoach(
+	ctx context.Context,
+	cmd *dtos.TransitionCoachCommand,
+) (*models.Coach, error) {
+	coach, err := c.coachRepo.GetCoachById(ctx, cmd.CoachId)
+	if err != nil {
+		return nil, err
+	}
+
+	from := coach.Status
+	if !from.CanTransitionTo(cmd.Status) {
+		return nil, customErrors.FailedPrecondition("coach", cmd.CoachId.String(),
+			fmt.Errorf("%w: %s to %s", customErrors.InvalidCoachTransition, from, cmd.Status))
+	}
+
+	if cmd.Status == models.CoachInReview || cmd.Status == models.CoachPublished {
+		var violations []customErrors.FieldViolation
+		if coach.Description == "" {
+			violations = append(violations, customErrors.FieldViolation{Field: "status", Description: "requires a coach description"})
+		}
+		if coach.Photo == "" {
+			violations = append(violations, customErrors.FieldViolation{Field: "status", Description: "requires a coach photo"})
+		}
+		if len(violations) > 0 {
+			return nil, customErrors.Invalid(violations...)
+		}
+	}
+
+	if err = c.coachRepo.SetCoachStatus(ctx, cmd.CoachId, from, cmd); err != nil {
+		return nil, err
+	}
+
+	metrics.CoachTransitions.WithLabelValues(string(from), string(cmd.Status)).Inc()
+	if from == models.CoachPublished || cmd.Status == models.CoachPublished {
+		c.invalidateCoaches()
+	}
+
+	logger.Info(ctx, "Coach status changed", "from", from, "to", cmd.Status)
+
+	return c.coachRepo.GetCoachById(ctx, cmd.CoachId)
+}
+
+// ScheduleCoach sets the window a published coach is public in. The cached
+// coaches listing may lag a window opening or closing by its TTL.
+func (c *CoachUseCase) ScheduleCoach(
+	ctx context.Context,
+	cmd *dtos.ScheduleCoachCommand,
+) (*models.Coach, error) {
+	if err := c.coachRepo.ScheduleCoach(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	c.invalidateCoaches()
+
+	return c.coachRepo.GetCoachById(ctx, cmd.CoachId)
+}
+
+// ArchiveExpiredCoaches archives published coaches whose publication window
+// has closed; they are already hidden from clients, this settles their status
+func (c *CoachUseCase) ArchiveExpiredCoaches(ctx context.Context) error {
+	ids, err := c.coachRepo.ArchiveExpiredCoaches(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	metrics.CoachTransitions.WithLabelValues(string(models.CoachPublished), string(models.CoachArchived)).Add(float64(len(ids)))
+	c.invalidateCoaches()
+
+	logger.Info(ctx, "Archived coaches past their publication window", "coaches", len(ids))
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type ContactUseCase interface {
+	SetCoachContacts(ctx context.Context, coachId uuid.UUID, contacts []*models.CoachContact) ([]*models.CoachContact, error)
+	// GetCoachContacts returns the contacts shown to audience
+	GetCoachContacts(
+		ctx context.Context,
+		coachId uuid.UUID,
+		audience models.ContactVisibility,
+		publicOnly bool,
+	) ([]*models.CoachContact, error)
+}
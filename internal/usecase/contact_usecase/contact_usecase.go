@@ -0,0 +1,67 @@
+package contact_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ usecase.ContactUseCase = (*ContactUseCase)(nil)
+
+type ContactUseCase struct {
+	contactRepo repository.ContactRepository
+	coachRepo   repository.CoachRepository
+}
+
+func NewContactUseCase(
+	contactRepo repository.ContactRepository,
+	coachRepo repository.CoachRepository,
+) *ContactUseCase {
+	return &ContactUseCase{
+		contactRepo: contactRepo,
+		coachRepo:   coachRepo,
+	}
+}
+
+func (c *ContactUseCase) SetCoachContacts(
+	ctx context.Context,
+	coachId uuid.UUID,
+	contacts []*models.CoachContact,
+) ([]*models.CoachContact, error) {
+	if _, err := c.coachRepo.GetCoachById(ctx, coachId); err != nil {
+		return nil, err
+	}
+
+	updatedTime := time.Now()
+	for _, contact := range contacts {
+		contact.CoachId = coachId
+		contact.UpdatedTime = updatedTime
+	}
+
+	if err := c.contactRepo.SetCoachContacts(ctx, coachId, contacts); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+func (c *ContactUseCase) GetCoachContacts(
+	ctx context.Context,
+	coachId uuid.UUID,
+	audience models.ContactVisibility,
+	publicOnly bool,
+) ([]*models.CoachContact, error) {
+	if _, err := usecase.VisibleCoach(ctx, c.coachRepo, coachId, publicOnly); err != nil {
+		return nil, err
+	}
+
+	contacts, err := c.contactRepo.GetCoachContacts(ctx, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.VisibleContacts(contacts, audience), nil
+}
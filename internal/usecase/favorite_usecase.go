@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type FavoriteUseCase interface {
+	// AddFavoriteCoach fails with errors.UserNotFound for users unknown to
+	// the User service
+	AddFavoriteCoach(ctx context.Context, userId uuid.UUID, coachId uuid.UUID, publicOnly bool) (*models.CoachFavoriteStats, error)
+	RemoveFavoriteCoach(ctx context.Context, userId uuid.UUID, coachId uuid.UUID) (*models.CoachFavoriteStats, error)
+
+	GetCoachFavoriteStats(ctx context.Context, coachId uuid.UUID, userId uuid.UUID, publicOnly bool) (*models.CoachFavoriteStats, error)
+	// GetCoachesFavoriteStats returns the stats of the coaches filter lists,
+	// in the listing order
+	GetCoachesFavoriteStats(ctx context.Context, filter dtos.CoachesFilter, userId uuid.UUID) ([]*models.CoachFavoriteStats, error)
+}
@@ -0,0 +1,157 @@
+package favorite_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	userGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.user"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var _ usecase.FavoriteUseCase = (*FavoriteUseCase)(nil)
+
+type FavoriteUseCase struct {
+	favoriteRepo repository.FavoriteRepository
+	coachRepo    repository.CoachRepository
+	userClient   *userGRPC.UserClient
+}
+
+func NewFavoriteUseCase(
+	favoriteRepo repository.FavoriteRepository,
+	coachRepo repository.CoachRepository,
+	userClient *userGRPC.UserClient,
+) *FavoriteUseCase {
+	return &FavoriteUseCase{
+		favoriteRepo: favoriteRepo,
+		coachRepo:    coachRepo,
+		userClient:   userClient,
+	}
+}
+
+func (c *FavoriteUseCase) AddFavoriteCoach(
+	ctx context.Context,
+	userId uuid.UUID,
+	coachId uuid.UUID,
+	publicOnly bool,
+) (*models.CoachFavoriteStats, error) {
+	if err := c.checkCoach(ctx, coachId, publicOnly); err != nil {
+		return nil, err
+	}
+
+	_, err := (*c.userClient).GetUserById(ctx, &userGRPC.GetUserByIdRequest{Id: userId.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, customErrors.NotFound("user", userId.String(), customErrors.UserNotFound)
+		}
+
+		return nil, customErrors.DependencyFailure(string(models.UserDependency), err)
+	}
+
+	err = c.favoriteRepo.AddFavorite(ctx, &models.CoachFavorite{
+		UserId:      userId,
+		CoachId:     coachId,
+		CreatedTime: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.coachFavoriteStats(ctx, coachId, userId)
+}
+
+func (c *FavoriteUseCase) RemoveFavoriteCoach(
+	ctx context.Context,
+	userId uuid.UUID,
+	coachId uuid.UUID,
+) (*models.CoachFavoriteStats, error) {
+	if err := c.favoriteRepo.RemoveFavorite(ctx, userId, coachId); err != nil {
+		return nil, err
+	}
+
+	return c.coachFavoriteStats(ctx, coachId, userId)
+}
+
+func (c *FavoriteUseCase) GetCoachFavoriteStats(
+	ctx context.Context,
+	coachId uuid.UUID,
+	userId uuid.UUID,
+	publicOnly bool,
+) (*models.CoachFavoriteStats, error) {
+	if err := c.checkCoach(ctx, coachId, publicOnly); err != nil {
+		return nil, err
+	}
+
+	return c.coachFavoriteStats(ctx, coachId, userId)
+}
+
+func (c *FavoriteUseCase) GetCoachesFavoriteStats(
+	ctx context.Context,
+	filter dtos.CoachesFilter,
+	userId uuid.UUID,
+) ([]*models.CoachFavoriteStats, error) {
+	coaches, err := c.coachRepo.GetCoaches(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	coachIds := make([]uuid.UUID, 0, len(coaches))
+	for _, coach := range coaches {
+		coachIds = append(coachIds, coach.Id)
+	}
+
+	return c.coachesFavoriteStats(ctx, coachIds, userId)
+}
+
+func (c *FavoriteUseCase) checkCoach(ctx context.Context, coachId uuid.UUID, publicOnly bool) error {
+	_, err := usecase.VisibleCoach(ctx, c.coachRepo, coachId, publicOnly)
+	return err
+}
+
+func (c *FavoriteUseCase) coachFavoriteStats(
+	ctx context.Context,
+	coachId uuid.UUID,
+	userId uuid.UUID,
+) (*models.CoachFavoriteStats, error) {
+	stats, err := c.coachesFavoriteStats(ctx, []uuid.UUID{coachId}, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats[0], nil
+}
+
+// coachesFavoriteStats returns the stats of coachIds in their order, empty
+// ones for coaches nobody favorited
+func (c *FavoriteUseCase) coachesFavoriteStats(
+	ctx context.Context,
+	coachIds []uuid.UUID,
+	userId uuid.UUID,
+) ([]*models.CoachFavoriteStats, error) {
+	stored, err := c.favoriteRepo.GetCoachesFavoriteStats(ctx, coachIds, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	coachIdStats := make(map[uuid.UUID]*models.CoachFavoriteStats, len(stored))
+	for _, coachStats := range stored {
+		coachIdStats[coachStats.CoachId] = coachStats
+	}
+
+	stats := make([]*models.CoachFavoriteStats, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		coachStats, ok := coachIdStats[coachId]
+		if !ok {
+			coachStats = &models.CoachFavoriteStats{CoachId: coachId}
+		}
+
+		stats = append(stats, coachStats)
+	}
+
+	return stats, nil
+}
@@ -3,11 +3,14 @@ package localstack_usecase
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
 	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
 	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"strings"
 )
 
@@ -30,8 +33,8 @@ func (luc *LocalstackUseCase) PutObject(ctx context.Context, object []byte, name
 		Body:   bytes.NewReader(object),
 	})
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to put object: %v", err)
-		return "", err
+		logger.Error(ctx, "Failed to put object", "key", name, "error", err)
+		return "", customErrors.StorageFailure("PutObject", err)
 	}
 
 	fileURL := fmt.Sprintf("%s/%s/%s", luc.config.EndPoint, luc.config.Bucket, name)
@@ -48,8 +51,8 @@ func (luc *LocalstackUseCase) DeleteObject(ctx context.Context, name string) err
 		Key:    aws.String(name),
 	})
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to delete object: %v", err)
-		return err
+		logger.Error(ctx, "Failed to delete object", "key", name, "error", err)
+		return customErrors.StorageFailure("DeleteObject", err)
 	}
 
 	return nil
@@ -61,9 +64,25 @@ func (luc *LocalstackUseCase) ObjectExists(ctx context.Context, name string) (bo
 		Key:    aws.String(name),
 	})
 	if err != nil {
-		logger.ErrorLogger.Printf("Failed to check object existence: %v", err)
-		return false, err
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+
+		logger.Error(ctx, "Failed to check object existence", "key", name, "error", err)
+		return false, customErrors.StorageFailure("HeadObject", err)
 	}
 
 	return true, nil
 }
+
+func (luc *LocalstackUseCase) Ping(ctx context.Context) error {
+	_, err := luc.client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(luc.config.Bucket),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type LocationUseCase interface {
+	CreateLocation(ctx context.Context, cmd *dtos.CreateLocationCommand) (*models.Location, error)
+	GetLocationById(ctx context.Context, id uuid.UUID) (*models.Location, error)
+	UpdateLocation(ctx context.Context, cmd *dtos.UpdateLocationCommand) (*models.Location, error)
+	DeleteLocationById(ctx context.Context, id uuid.UUID) (*models.Location, error)
+	GetLocations(ctx context.Context) ([]*models.Location, error)
+
+	SetCoachLocations(ctx context.Context, cmd *dtos.SetCoachLocationsCommand) ([]*models.CoachLocation, error)
+	GetCoachLocations(ctx context.Context, coachId uuid.UUID, publicOnly bool) ([]*models.CoachLocation, error)
+	// GetCoachLocation fails with errors.CoachNotAtLocation when the coach
+	// isn't assigned to the location
+	GetCoachLocation(ctx context.Context, coachId, locationId uuid.UUID, publicOnly bool) (*models.CoachLocation, error)
+}
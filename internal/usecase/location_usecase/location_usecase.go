@@ -0,0 +1,145 @@
+package location_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ usecase.LocationUseCase = (*LocationUseCase)(nil)
+
+type LocationUseCase struct {
+	locationRepo repository.LocationRepository
+	coachRepo    repository.CoachRepository
+}
+
+func NewLocationUseCase(
+	locationRepo repository.LocationRepository,
+	coachRepo repository.CoachRepository,
+) *LocationUseCase {
+	return &LocationUseCase{
+		locationRepo: locationRepo,
+		coachRepo:    coachRepo,
+	}
+}
+
+func (l *LocationUseCase) CreateLocation(
+	ctx context.Context,
+	cmd *dtos.CreateLocationCommand,
+) (*models.Location, error) {
+	location := &models.Location{
+		Id:          cmd.Id,
+		Name:        cmd.Name,
+		Address:     cmd.Address,
+		UpdatedTime: time.Now(),
+		CreatedTime: time.Now(),
+	}
+
+	return l.locationRepo.CreateLocation(ctx, location)
+}
+
+func (l *LocationUseCase) GetLocationById(
+	ctx context.Context,
+	id uuid.UUID,
+) (*models.Location, error) {
+	return l.locationRepo.GetLocationById(ctx, id)
+}
+
+func (l *LocationUseCase) UpdateLocation(
+	ctx context.Context,
+	cmd *dtos.UpdateLocationCommand,
+) (*models.Location, error) {
+	if err := l.locationRepo.UpdateLocation(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	return l.locationRepo.GetLocationById(ctx, cmd.Id)
+}
+
+func (l *LocationUseCase) DeleteLocationById(
+	ctx context.Context,
+	id uuid.UUID,
+) (*models.Location, error) {
+	location, err := l.locationRepo.GetLocationById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = l.locationRepo.DeleteLocationById(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (l *LocationUseCase) GetLocations(ctx context.Context) ([]*models.Location, error) {
+	return l.locationRepo.GetLocations(ctx)
+}
+
+func (l *LocationUseCase) SetCoachLocations(
+	ctx context.Context,
+	cmd *dtos.SetCoachLocationsCommand,
+) ([]*models.CoachLocation, error) {
+	if _, err := l.coachRepo.GetCoachById(ctx, cmd.CoachId); err != nil {
+		return nil, err
+	}
+
+	if len(cmd.LocationIds) > 0 {
+		locations, err := l.locationRepo.GetLocations(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		known := make(map[uuid.UUID]struct{}, len(locations))
+		for _, location := range locations {
+			known[location.Id] = struct{}{}
+		}
+
+		for _, locationId := range cmd.LocationIds {
+			if _, ok := known[locationId]; !ok {
+				return nil, customErrors.NotFound("location", locationId.String(), customErrors.LocationNotFound)
+			}
+		}
+	}
+
+	if err := l.locationRepo.SetCoachLocations(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	return l.locationRepo.GetCoachLocations(ctx, cmd.CoachId)
+}
+
+func (l *LocationUseCase) GetCoachLocations(
+	ctx context.Context,
+	coachId uuid.UUID,
+	publicOnly bool,
+) ([]*models.CoachLocation, error) {
+	if _, err := usecase.VisibleCoach(ctx, l.coachRepo, coachId, publicOnly); err != nil {
+		return nil, err
+	}
+
+	return l.locationRepo.GetCoachLocations(ctx, coachId)
+}
+
+// GetCoachLocation tells a missing coach or location apart from a coach who
+// doesn't work at the location
+func (l *LocationUseCase) GetCoachLocation(
+	ctx context.Context,
+	coachId, locationId uuid.UUID,
+	publicOnly bool,
+) (*models.CoachLocation, error) {
+	if _, err := usecase.VisibleCoach(ctx, l.coachRepo, coachId, publicOnly); err != nil {
+		return nil, err
+	}
+
+	if _, err := l.locationRepo.GetLocationById(ctx, locationId); err != nil {
+		return nil, err
+	}
+
+	return l.locationRepo.GetCoachLocation(ctx, coachId, locationId)
+}
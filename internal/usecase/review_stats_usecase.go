@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type ReviewStatsUseCase interface {
+	// RefreshReviewStats recomputes the stats of every coach
+	RefreshReviewStats(ctx context.Context) error
+	RefreshCoachReviewStats(ctx context.Context, coachId uuid.UUID) (*models.CoachReviewStats, error)
+
+	GetCoachReviewStats(ctx context.Context, coachId uuid.UUID, publicOnly bool) (*models.CoachReviewStats, error)
+	// GetCoachesReviewStats returns the stats of the coaches filter lists, in
+	// the listing order
+	GetCoachesReviewStats(ctx context.Context, filter dtos.CoachesFilter) ([]*models.CoachReviewStats, error)
+}
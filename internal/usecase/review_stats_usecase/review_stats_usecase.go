@@ -0,0 +1,228 @@
+package review_stats_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/metrics"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/DanKo-code/FitnessCenter-Coach/pkg/logger"
+	reviewGRPC "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.review"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// refreshBatchSize bounds the coaches whose reviews are requested at once
+const refreshBatchSize = 100
+
+// reviewTimeLayouts are those of time.Time.String, which the FitnessCenter
+// services format their times with, and RFC 3339
+var reviewTimeLayouts = []string{"2006-01-02 15:04:05.999999999 -0700 MST", time.RFC3339Nano}
+
+var _ usecase.ReviewStatsUseCase = (*ReviewStatsUseCase)(nil)
+
+type ReviewStatsUseCase struct {
+	statsRepo    repository.ReviewStatsRepository
+	coachRepo    repository.CoachRepository
+	reviewClient *reviewGRPC.ReviewClient
+	trendWindow  time.Duration
+}
+
+func NewReviewStatsUseCase(
+	statsRepo repository.ReviewStatsRepository,
+	coachRepo repository.CoachRepository,
+	reviewClient *reviewGRPC.ReviewClient,
+	trendWindow time.Duration,
+) *ReviewStatsUseCase {
+	return &ReviewStatsUseCase{
+		statsRepo:    statsRepo,
+		coachRepo:    coachRepo,
+		reviewClient: reviewClient,
+		trendWindow:  trendWindow,
+	}
+}
+
+func (c *ReviewStatsUseCase) RefreshReviewStats(ctx context.Context) error {
+	coaches, err := c.coachRepo.GetCoaches(ctx, dtos.CoachesFilter{})
+	if err != nil {
+		return err
+	}
+
+	coachIds := make([]uuid.UUID, 0, len(coaches))
+	for _, coach := range coaches {
+		coachIds = append(coachIds, coach.Id)
+	}
+
+	for start := 0; start < len(coachIds); start += refreshBatchSize {
+		if _, err = c.refresh(ctx, coachIds[start:min(start+refreshBatchSize, len(coachIds))]); err != nil {
+			return err
+		}
+	}
+
+	metrics.ReviewStatsRefreshed.SetToCurrentTime()
+
+	return nil
+}
+
+func (c *ReviewStatsUseCase) RefreshCoachReviewStats(ctx context.Context, coachId uuid.UUID) (*models.CoachReviewStats, error) {
+	if _, err := c.coachRepo.GetCoachById(ctx, coachId); err != nil {
+		return nil, err
+	}
+
+	stats, err := c.refresh(ctx, []uuid.UUID{coachId})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats[0], nil
+}
+
+func (c *ReviewStatsUseCase) GetCoachReviewStats(
+	ctx context.Context,
+	coachId uuid.UUID,
+	publicOnly bool,
+) (*models.CoachReviewStats, error) {
+	coach, err := usecase.VisibleCoach(ctx, c.coachRepo, coachId, publicOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := c.coachesReviewStats(ctx, []*models.Coach{coach})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats[0], nil
+}
+
+func (c *ReviewStatsUseCase) GetCoachesReviewStats(
+	ctx context.Context,
+	filter dtos.CoachesFilter,
+) ([]*models.CoachReviewStats, error) {
+	coaches, err := c.coachRepo.GetCoaches(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.coachesReviewStats(ctx, coaches)
+}
+
+// coachesReviewStats returns the stored stats of coaches in their order, empty
+// ones for coaches not refreshed yet
+func (c *ReviewStatsUseCase) coachesReviewStats(
+	ctx context.Context,
+	coaches []*models.Coach,
+) ([]*models.CoachReviewStats, error) {
+	coachIds := make([]uuid.UUID, 0, len(coaches))
+	for _, coach := range coaches {
+		coachIds = append(coachIds, coach.Id)
+	}
+
+	stored, err := c.statsRepo.GetCoachesReviewStats(ctx, coachIds)
+	if err != nil {
+		return nil, err
+	}
+
+	coachIdStats := make(map[uuid.UUID]*models.CoachReviewStats, len(stored))
+	for _, coachStats := range stored {
+		coachIdStats[coachStats.CoachId] = coachStats
+	}
+
+	stats := make([]*models.CoachReviewStats, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		coachStats, ok := coachIdStats[coachId]
+		if !ok {
+			coachStats = &models.CoachReviewStats{CoachId: coachId}
+		}
+
+		stats = append(stats, coachStats)
+	}
+
+	return stats, nil
+}
+
+// refresh recomputes and stores the stats of coachIds, in their order
+func (c *ReviewStatsUseCase) refresh(ctx context.Context, coachIds []uuid.UUID) ([]*models.CoachReviewStats, error) {
+	ids := make([]string, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		ids = append(ids, coachId.String())
+	}
+
+	getCoachesReviewsResponse, err := (*c.reviewClient).GetCoachesReviews(ctx, &reviewGRPC.GetCoachesReviewsRequest{
+		CoachesIds: ids,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed GetCoachesReviews", "error", err)
+		return nil, customErrors.DependencyFailure(string(models.ReviewDependency), err)
+	}
+
+	coachIdReviews := make(map[string][]*reviewGRPC.ReviewObject)
+	for _, i2 := range getCoachesReviewsResponse.CoachIdWithReviewObject {
+		coachIdReviews[i2.CoachId] = append(coachIdReviews[i2.CoachId], i2.ReviewObjects...)
+	}
+
+	now := time.Now()
+	stats := make([]*models.CoachReviewStats, 0, len(coachIds))
+	for _, coachId := range coachIds {
+		stats = append(stats, c.reviewStats(coachId, coachIdReviews[coachId.String()], now))
+	}
+
+	if err = c.statsRepo.SetCoachesReviewStats(ctx, stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (c *ReviewStatsUseCase) reviewStats(
+	coachId uuid.UUID,
+	reviews []*reviewGRPC.ReviewObject,
+	now time.Time,
+) *models.CoachReviewStats {
+	stats := &models.CoachReviewStats{
+		CoachId:       coachId,
+		ReviewCount:   len(reviews),
+		RefreshedTime: now,
+	}
+
+	recentSince := now.Add(-c.trendWindow)
+	previousSince := recentSince.Add(-c.trendWindow)
+	for _, review := range reviews {
+		//reviews of unknown age still count, only not towards the trend
+		createdTime, ok := parseReviewTime(review.CreatedTime)
+		if !ok {
+			continue
+		}
+
+		if stats.LastReviewTime == nil || createdTime.After(*stats.LastReviewTime) {
+			stats.LastReviewTime = &createdTime
+		}
+
+		switch {
+		case !createdTime.Before(recentSince):
+			stats.RecentCount++
+		case !createdTime.Before(previousSince):
+			stats.PreviousCount++
+		}
+	}
+
+	return stats
+}
+
+func parseReviewTime(value string) (time.Time, bool) {
+	//drop the monotonic clock reading time.Time.String appends to time.Now
+	if i := strings.Index(value, " m="); i >= 0 {
+		value = value[:i]
+	}
+
+	for _, layout := range reviewTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+
+	return time.Time{}, false
+}
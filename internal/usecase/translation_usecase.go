@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+)
+
+type TranslationUseCase interface {
+	SetCoachTranslation(ctx context.Context, cmd *dtos.SetCoachTranslationCommand) (*models.CoachTranslation, error)
+	DeleteCoachTranslation(ctx context.Context, coachId uuid.UUID, locale string) error
+	GetCoachTranslations(ctx context.Context, coachId uuid.UUID, publicOnly bool) ([]*models.CoachTranslation, error)
+	// GetCoachName returns the name a coach has in locale, its own one when
+	// the locale isn't translated
+	GetCoachName(ctx context.Context, coachId uuid.UUID, locale string) (string, error)
+
+	// DefaultLocale is the locale of a coach's own name and description
+	DefaultLocale() string
+}
@@ -0,0 +1,109 @@
+package translation_usecase
+
+import (
+	"context"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/usecase"
+	"github.com/google/uuid"
+	"time"
+)
+
+var _ usecase.TranslationUseCase = (*TranslationUseCase)(nil)
+
+// TranslationUseCase doesn't touch the coaches cache, which holds the default
+// locale and is translated on every read
+type TranslationUseCase struct {
+	translationRepo repository.TranslationRepository
+	coachRepo       repository.CoachRepository
+	resolver        *locale.Resolver
+}
+
+func NewTranslationUseCase(
+	translationRepo repository.TranslationRepository,
+	coachRepo repository.CoachRepository,
+	resolver *locale.Resolver,
+) *TranslationUseCase {
+	return &TranslationUseCase{
+		translationRepo: translationRepo,
+		coachRepo:       coachRepo,
+		resolver:        resolver,
+	}
+}
+
+func (t *TranslationUseCase) SetCoachTranslation(
+	ctx context.Context,
+	cmd *dtos.SetCoachTranslationCommand,
+) (*models.CoachTranslation, error) {
+	//the default locale is the coach itself, updated through UpdateCoach
+	if cmd.Locale == t.resolver.Default {
+		return nil, customErrors.Invalid(customErrors.FieldViolation{
+			Field:       "locale",
+			Description: "must not be the default locale " + t.resolver.Default,
+		})
+	}
+
+	translation := &models.CoachTranslation{
+		CoachId:     cmd.CoachId,
+		Locale:      cmd.Locale,
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		UpdatedTime: time.Now(),
+	}
+
+	if err := t.translationRepo.SetCoachTranslation(ctx, translation); err != nil {
+		return nil, err
+	}
+
+	return translation, nil
+}
+
+func (t *TranslationUseCase) DeleteCoachTranslation(
+	ctx context.Context,
+	coachId uuid.UUID,
+	locale string,
+) error {
+	return t.translationRepo.DeleteCoachTranslation(ctx, coachId, locale)
+}
+
+// GetCoachTranslations fails for unknown coaches rather than returning none
+func (t *TranslationUseCase) GetCoachTranslations(
+	ctx context.Context,
+	coachId uuid.UUID,
+	publicOnly bool,
+) ([]*models.CoachTranslation, error) {
+	if _, err := usecase.VisibleCoach(ctx, t.coachRepo, coachId, publicOnly); err != nil {
+		return nil, err
+	}
+
+	return t.translationRepo.GetCoachTranslations(ctx, coachId)
+}
+
+func (t *TranslationUseCase) GetCoachName(
+	ctx context.Context,
+	coachId uuid.UUID,
+	locale string,
+) (string, error) {
+	coach, err := t.coachRepo.GetCoachById(ctx, coachId)
+	if err != nil {
+		return "", err
+	}
+
+	translations, err := t.translationRepo.GetTranslations(ctx, []uuid.UUID{coachId}, []string{locale})
+	if err != nil {
+		return "", err
+	}
+
+	if len(translations) > 0 {
+		return translations[0].Name, nil
+	}
+
+	return coach.Name, nil
+}
+
+func (t *TranslationUseCase) DefaultLocale() string {
+	return t.resolver.Default
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/repository"
+	"github.com/google/uuid"
+	"time"
+)
+
+// VisibleCoach returns the coach read by id. With publicOnly set, coaches
+// clients can't see fail with errors.CoachNotFound like missing ones, so a
+// public read never tells them apart; the publicOnly parameters of the use
+// cases all mean this.
+func VisibleCoach(
+	ctx context.Context,
+	coachRepo repository.CoachRepository,
+	coachId uuid.UUID,
+	publicOnly bool,
+) (*models.Coach, error) {
+	coach, err := coachRepo.GetCoachById(ctx, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	if publicOnly && !coach.IsPublic(time.Now()) {
+		return nil, customErrors.NotFound("coach", coachId.String(), customErrors.CoachNotFound)
+	}
+
+	return coach, nil
+}
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	coachProfileProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_profile"
+	"github.com/google/uuid"
+)
+
+// LinkCoachUser returns the parsed coach and user ids
+func LinkCoachUser(request *coachProfileProtobuf.LinkCoachUserRequest) (uuid.UUID, uuid.UUID, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+	userId := v.UUID("user_id", request.UserId)
+
+	return coachId, userId, v.Err()
+}
+
+// MyCoachProfileData allows a missing data message when a photo is sent
+func MyCoachProfileData(data *coachProfileProtobuf.MyCoachProfileData, photo []byte) error {
+	v := New()
+
+	if data == nil && photo == nil {
+		v.Violate("data", "is required")
+		return v.Err()
+	}
+
+	v.MaxLength("data.description", data.GetDescription(), MaxDescriptionLength)
+	v.MaxBytes("photo_chunk", photo, MaxPhotoBytes)
+
+	return v.Err()
+}
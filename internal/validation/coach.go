@@ -0,0 +1,95 @@
+package validation
+
+import (
+	coachTransferProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_transfer"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/dtos"
+	coachProtobuf "github.com/DanKo-code/FitnessCenter-Protobuf/gen/FitnessCenter.protobuf.coach"
+	"github.com/google/uuid"
+)
+
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 4000
+	MaxPhotoBytes        = 10 << 20
+)
+
+// CoachId validates a coach id sent by a client
+func CoachId(field, id string) (uuid.UUID, error) {
+	v := New()
+	parsedId := v.UUID(field, id)
+
+	return parsedId, v.Err()
+}
+
+var coachesSorts = map[string]dtos.CoachesSort{
+	string(dtos.CoachesByReviewCount):  dtos.CoachesByReviewCount,
+	string(dtos.CoachesByReviewTrend):  dtos.CoachesByReviewTrend,
+	string(dtos.CoachesFavoritesFirst): dtos.CoachesFavoritesFirst,
+}
+
+// CoachesSort parses the order of a coach listing
+func CoachesSort(field, value string) (dtos.CoachesSort, error) {
+	v := New()
+
+	sortBy, ok := coachesSorts[value]
+	if !ok {
+		v.Violate(field, "must be review_count, review_trend or favorites")
+	}
+
+	return sortBy, v.Err()
+}
+
+func CoachDataForCreate(data *coachProtobuf.CoachDataForCreate, photo []byte) error {
+	v := New()
+
+	if data == nil {
+		v.Violate("coach_data_for_create", "is required")
+		return v.Err()
+	}
+
+	v.Required("coach_data_for_create.name", data.Name)
+	v.MaxLength("coach_data_for_create.name", data.Name, MaxNameLength)
+	v.MaxLength("coach_data_for_create.description", data.Description, MaxDescriptionLength)
+	v.UUIDs("coach_data_for_create.coach_service_ids", data.CoachServiceIds)
+	v.MaxBytes("coach_photo", photo, MaxPhotoBytes)
+
+	return v.Err()
+}
+
+// CoachDataForUpdate allows empty fields, which are left unchanged
+func CoachDataForUpdate(data *coachProtobuf.CoachDataForUpdate, photo []byte) (uuid.UUID, error) {
+	v := New()
+
+	if data == nil {
+		v.Violate("coach_data_for_update", "is required")
+		return uuid.Nil, v.Err()
+	}
+
+	id := v.UUID("coach_data_for_update.id", data.Id)
+	v.MaxLength("coach_data_for_update.name", data.Name, MaxNameLength)
+	v.MaxLength("coach_data_for_update.description", data.Description, MaxDescriptionLength)
+	v.UUIDs("coach_data_for_update.coach_service_ids", data.CoachServiceIds)
+	v.MaxBytes("coach_photo", photo, MaxPhotoBytes)
+
+	return id, v.Err()
+}
+
+const MaxExternalIdLength = 100
+
+// CoachRecord validates an imported coach and the photo sent after it; record
+// field names are prefixed with "record."
+func CoachRecord(record *coachTransferProtobuf.CoachRecord, photo []byte) error {
+	v := New()
+
+	v.MaxLength("record.external_id", record.ExternalId, MaxExternalIdLength)
+	v.Required("record.name", record.Name)
+	v.MaxLength("record.name", record.Name, MaxNameLength)
+	v.MaxLength("record.description", record.Description, MaxDescriptionLength)
+	v.UUIDs("record.coach_service_ids", record.CoachServiceIds)
+	v.MaxBytes("photo_chunk", photo, MaxPhotoBytes)
+	if record.PhotoUrl != "" {
+		v.HTTPURL("record.photo_url", record.PhotoUrl)
+	}
+
+	return v.Err()
+}
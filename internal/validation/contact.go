@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"fmt"
+	coachContactProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_contact"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const MaxContactValueLength = 500
+
+var (
+	// phonePattern accepts E.164 numbers
+	phonePattern    = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	telegramPattern = regexp.MustCompile(`^@?[A-Za-z0-9_]{5,32}$`)
+)
+
+var contactKinds = map[coachContactProtobuf.ContactKind]models.ContactKind{
+	coachContactProtobuf.ContactKind_EMAIL:     models.ContactEmail,
+	coachContactProtobuf.ContactKind_PHONE:     models.ContactPhone,
+	coachContactProtobuf.ContactKind_TELEGRAM:  models.ContactTelegram,
+	coachContactProtobuf.ContactKind_WHATSAPP:  models.ContactWhatsApp,
+	coachContactProtobuf.ContactKind_VIBER:     models.ContactViber,
+	coachContactProtobuf.ContactKind_INSTAGRAM: models.ContactInstagram,
+	coachContactProtobuf.ContactKind_FACEBOOK:  models.ContactFacebook,
+	coachContactProtobuf.ContactKind_VK:        models.ContactVK,
+	coachContactProtobuf.ContactKind_YOUTUBE:   models.ContactYouTube,
+	coachContactProtobuf.ContactKind_WEBSITE:   models.ContactWebsite,
+}
+
+var contactVisibilities = map[coachContactProtobuf.ContactVisibility]models.ContactVisibility{
+	coachContactProtobuf.ContactVisibility_PUBLIC:  models.ContactPublic,
+	coachContactProtobuf.ContactVisibility_MEMBERS: models.ContactMembers,
+	coachContactProtobuf.ContactVisibility_STAFF:   models.ContactStaff,
+}
+
+// socialHosts are the sites the URL of a social network contact must be on,
+// subdomains included
+var socialHosts = map[models.ContactKind][]string{
+	models.ContactInstagram: {"instagram.com"},
+	models.ContactFacebook:  {"facebook.com", "fb.com"},
+	models.ContactVK:        {"vk.com"},
+	models.ContactYouTube:   {"youtube.com", "youtu.be"},
+}
+
+// SetCoachContacts returns the parsed coach id and contacts, without their
+// coach id and update time
+func SetCoachContacts(request *coachContactProtobuf.SetCoachContactsRequest) (uuid.UUID, []*models.CoachContact, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+
+	seen := make(map[models.ContactKind]struct{}, len(request.Contacts))
+	contacts := make([]*models.CoachContact, 0, len(request.Contacts))
+	for i, contactObject := range request.Contacts {
+		field := fmt.Sprintf("contacts[%d]", i)
+
+		kind, ok := contactKinds[contactObject.GetKind()]
+		if !ok {
+			v.Violate(field+".kind", "must be a contact kind")
+			continue
+		}
+		if _, ok = seen[kind]; ok {
+			v.Violate(field+".kind", "is a duplicate")
+		}
+		seen[kind] = struct{}{}
+
+		visibility, ok := contactVisibilities[contactObject.GetVisibility()]
+		if !ok {
+			v.Violate(field+".visibility", "must be a contact visibility")
+		}
+
+		value := strings.TrimSpace(contactObject.GetValue())
+		v.contactValue(field+".value", kind, value)
+
+		contacts = append(contacts, &models.CoachContact{
+			Kind:       kind,
+			Value:      value,
+			Visibility: visibility,
+		})
+	}
+
+	return coachId, contacts, v.Err()
+}
+
+func (v *Validator) contactValue(field string, kind models.ContactKind, value string) {
+	if value == "" {
+		v.Violate(field, "is required")
+		return
+	}
+	v.MaxLength(field, value, MaxContactValueLength)
+
+	switch kind {
+	case models.ContactEmail:
+		address, err := mail.ParseAddress(value)
+		if err != nil || address.Address != value {
+			v.Violate(field, "must be an email address")
+		}
+	case models.ContactPhone, models.ContactWhatsApp, models.ContactViber:
+		if !phonePattern.MatchString(value) {
+			v.Violate(field, "must be a phone number in E.164 format, e.g. +375291234567")
+		}
+	case models.ContactTelegram:
+		if !telegramPattern.MatchString(value) {
+			v.Violate(field, "must be a Telegram username")
+		}
+	default:
+		parsed, err := url.Parse(value)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			v.Violate(field, "must be an https URL")
+			return
+		}
+
+		if hosts, ok := socialHosts[kind]; ok && !onHost(parsed.Hostname(), hosts) {
+			v.Violate(field, "must be on "+strings.Join(hosts, " or "))
+		}
+	}
+}
+
+func onHost(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	for _, candidate := range hosts {
+		if host == candidate || strings.HasSuffix(host, "."+candidate) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package validation
+
+import (
+	coachLocationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_location"
+	"github.com/google/uuid"
+	"slices"
+)
+
+const (
+	MaxLocationNameLength    = 100
+	MaxLocationAddressLength = 500
+)
+
+// LocationId validates a location id sent by a client
+func LocationId(field, id string) (uuid.UUID, error) {
+	v := New()
+	parsedId := v.UUID(field, id)
+
+	return parsedId, v.Err()
+}
+
+func CreateLocation(request *coachLocationProtobuf.CreateLocationRequest) error {
+	v := New()
+
+	v.Required("name", request.Name)
+	v.MaxLength("name", request.Name, MaxLocationNameLength)
+	v.MaxLength("address", request.Address, MaxLocationAddressLength)
+
+	return v.Err()
+}
+
+// UpdateLocation allows empty fields, which are left unchanged
+func UpdateLocation(request *coachLocationProtobuf.UpdateLocationRequest) (uuid.UUID, error) {
+	v := New()
+
+	id := v.UUID("id", request.Id)
+	v.MaxLength("name", request.Name, MaxLocationNameLength)
+	v.MaxLength("address", request.Address, MaxLocationAddressLength)
+
+	return id, v.Err()
+}
+
+// SetCoachLocations returns the parsed coach, location and primary location
+// ids
+func SetCoachLocations(request *coachLocationProtobuf.SetCoachLocationsRequest) (uuid.UUID, []uuid.UUID, uuid.UUID, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+	v.UUIDs("location_ids", request.LocationIds)
+
+	locationIds := make([]uuid.UUID, 0, len(request.LocationIds))
+	for _, locationId := range request.LocationIds {
+		if parsedId, err := uuid.Parse(locationId); err == nil {
+			locationIds = append(locationIds, parsedId)
+		}
+	}
+
+	var primaryLocationId uuid.UUID
+	switch {
+	case len(request.LocationIds) == 0 && request.PrimaryLocationId != "":
+		v.Violate("primary_location_id", "must be empty when location_ids is")
+	case len(request.LocationIds) > 0:
+		primaryLocationId = v.UUID("primary_location_id", request.PrimaryLocationId)
+		if primaryLocationId != uuid.Nil && !slices.Contains(locationIds, primaryLocationId) {
+			v.Violate("primary_location_id", "must be one of location_ids")
+		}
+	}
+
+	return coachId, locationIds, primaryLocationId, v.Err()
+}
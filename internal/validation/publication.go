@@ -0,0 +1,77 @@
+package validation
+
+import (
+	coachPublicationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_publication"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+var coachStatuses = map[coachPublicationProtobuf.CoachStatus]models.CoachStatus{
+	coachPublicationProtobuf.CoachStatus_DRAFT:     models.CoachDraft,
+	coachPublicationProtobuf.CoachStatus_IN_REVIEW: models.CoachInReview,
+	coachPublicationProtobuf.CoachStatus_PUBLISHED: models.CoachPublished,
+	coachPublicationProtobuf.CoachStatus_ARCHIVED:  models.CoachArchived,
+}
+
+// CoachStatus returns the status of a specified enum value, or an empty one
+// after recording a violation
+func (v *Validator) CoachStatus(field string, status coachPublicationProtobuf.CoachStatus) models.CoachStatus {
+	coachStatus, ok := coachStatuses[status]
+	if !ok {
+		v.Violate(field, "must be a coach status")
+	}
+
+	return coachStatus
+}
+
+// Time parses an optional RFC 3339 time, returning nil when value is empty
+func (v *Validator) Time(field, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		v.Violate(field, "must be an RFC 3339 time")
+		return nil
+	}
+
+	return &parsed
+}
+
+func TransitionCoach(request *coachPublicationProtobuf.TransitionCoachRequest) (uuid.UUID, models.CoachStatus, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+	status := v.CoachStatus("status", request.Status)
+
+	return coachId, status, v.Err()
+}
+
+// ScheduleCoach returns the parsed coach id and publication window
+func ScheduleCoach(request *coachPublicationProtobuf.ScheduleCoachRequest) (uuid.UUID, *time.Time, *time.Time, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+	publishAt := v.Time("publish_at", request.PublishAt)
+	unpublishAt := v.Time("unpublish_at", request.UnpublishAt)
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		v.Violate("unpublish_at", "must be after publish_at")
+	}
+
+	return coachId, publishAt, unpublishAt, v.Err()
+}
+
+// GetCoachesByStatus returns an empty status for an unspecified one, which
+// lists every coach
+func GetCoachesByStatus(request *coachPublicationProtobuf.GetCoachesByStatusRequest) (models.CoachStatus, error) {
+	if request.Status == coachPublicationProtobuf.CoachStatus_COACH_STATUS_UNSPECIFIED {
+		return "", nil
+	}
+
+	v := New()
+	status := v.CoachStatus("status", request.Status)
+
+	return status, v.Err()
+}
@@ -0,0 +1,28 @@
+package validation
+
+import (
+	coachTranslationProtobuf "github.com/DanKo-code/FitnessCenter-Coach/gen/FitnessCenter.protobuf.coach_translation"
+	"github.com/google/uuid"
+)
+
+// SetCoachTranslation returns the parsed coach id and normalized locale
+func SetCoachTranslation(request *coachTranslationProtobuf.SetCoachTranslationRequest) (uuid.UUID, string, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+	tag := v.Locale("locale", request.Locale)
+	v.Required("name", request.Name)
+	v.MaxLength("name", request.Name, MaxNameLength)
+	v.MaxLength("description", request.Description, MaxDescriptionLength)
+
+	return coachId, tag, v.Err()
+}
+
+func DeleteCoachTranslation(request *coachTranslationProtobuf.DeleteCoachTranslationRequest) (uuid.UUID, string, error) {
+	v := New()
+
+	coachId := v.UUID("coach_id", request.CoachId)
+	tag := v.Locale("locale", request.Locale)
+
+	return coachId, tag, v.Err()
+}
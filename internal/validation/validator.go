@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"fmt"
+	customErrors "github.com/DanKo-code/FitnessCenter-Coach/internal/errors"
+	"github.com/DanKo-code/FitnessCenter-Coach/internal/locale"
+	"github.com/google/uuid"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator collects field violations so a client learns about every invalid
+// field of a request at once
+type Validator struct {
+	violations []customErrors.FieldViolation
+}
+
+func New() *Validator {
+	return &Validator{}
+}
+
+func (v *Validator) Violate(field, description string) {
+	v.violations = append(v.violations, customErrors.FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// UUID returns the parsed id, or uuid.Nil after recording a violation
+func (v *Validator) UUID(field, value string) uuid.UUID {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		v.Violate(field, "must be a UUID")
+		return uuid.Nil
+	}
+
+	return id
+}
+
+// UUIDs rejects duplicates by value, as one UUID has several spellings
+func (v *Validator) UUIDs(field string, values []string) {
+	seen := make(map[uuid.UUID]struct{}, len(values))
+
+	for i, value := range values {
+		indexedField := fmt.Sprintf("%s[%d]", field, i)
+
+		id, err := uuid.Parse(value)
+		if err != nil {
+			v.Violate(indexedField, "must be a UUID")
+			continue
+		}
+
+		if _, ok := seen[id]; ok {
+			v.Violate(indexedField, "is a duplicate")
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func (v *Validator) Required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.Violate(field, "is required")
+	}
+}
+
+// MaxLength counts characters, not bytes
+func (v *Validator) MaxLength(field, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		v.Violate(field, fmt.Sprintf("must be at most %d characters", max))
+	}
+}
+
+func (v *Validator) MaxBytes(field string, value []byte, max int) {
+	if len(value) > max {
+		v.Violate(field, fmt.Sprintf("must be at most %d bytes", max))
+	}
+}
+
+func (v *Validator) HTTPURL(field, value string) {
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		v.Violate(field, "must be an http or https URL")
+	}
+}
+
+// Err returns a validation error carrying every violation, or nil
+func (v *Validator) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return customErrors.Invalid(v.violations...)
+}
+
+// Locale returns the normalized form of a language tag sent by a client
+func (v *Validator) Locale(field, tag string) string {
+	normalized := locale.Normalize(tag)
+
+	switch {
+	case normalized == "":
+		v.Violate(field, "is required")
+	case !locale.Valid(normalized):
+		v.Violate(field, "must be a language tag like \"en\" or \"en-GB\"")
+	}
+
+	return normalized
+}
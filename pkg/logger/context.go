@@ -0,0 +1,108 @@
+package logger
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"log/slog"
+	"runtime"
+	"time"
+)
+
+// RequestIDKey is the metadata key carrying the request ID between services
+const RequestIDKey = "x-request-id"
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	coachIDKey
+)
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID returns the request ID stored in ctx, falling back to the one
+// sent by the caller in the incoming metadata
+func RequestID(ctx context.Context) string {
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok {
+		return requestID
+	}
+
+	if requestIDs := metadata.ValueFromIncomingContext(ctx, RequestIDKey); len(requestIDs) > 0 {
+		return requestIDs[0]
+	}
+
+	return ""
+}
+
+func WithCoachID(ctx context.Context, coachID string) context.Context {
+	return context.WithValue(ctx, coachIDKey, coachID)
+}
+
+func CoachID(ctx context.Context) string {
+	coachID, _ := ctx.Value(coachIDKey).(string)
+	return coachID
+}
+
+// contextHandler adds the request ID, RPC method, coach ID and trace ID
+// found in the record context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func newContextHandler(handler slog.Handler) *contextHandler {
+	return &contextHandler{Handler: handler}
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := RequestID(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+
+	if method, ok := grpc.Method(ctx); ok {
+		record.AddAttrs(slog.String("rpc_method", method))
+	}
+
+	if coachID := CoachID(ctx); coachID != "" {
+		record.AddAttrs(slog.String("coach_id", coachID))
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return newContextHandler(h.Handler.WithAttrs(attrs))
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return newContextHandler(h.Handler.WithGroup(name))
+}
+
+// log reports the caller of the package level helpers as the record source
+func log(ctx context.Context, logLevel slog.Level, msg string, args ...any) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if !base.Enabled(ctx, logLevel) {
+		return
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+
+	record := slog.NewRecord(time.Now(), logLevel, msg, pcs[0])
+	record.Add(args...)
+
+	_ = base.Handler().Handle(ctx, record)
+}
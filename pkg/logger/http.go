@@ -0,0 +1,27 @@
+package logger
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// LevelHandler changes the level on POST with a "level" query parameter,
+// e.g. POST /loglevel?level=debug, and replies with the new level
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if err := SetLevel(r.URL.Query().Get("level")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		Info(r.Context(), "log level changed", "level", Level().String())
+
+		_, _ = fmt.Fprintln(w, Level().String())
+	})
+}
@@ -1,13 +1,58 @@
 package logger
 
 import (
-	"log"
+	"context"
+	"log/slog"
 	"os"
 )
 
 var (
-	InfoLogger  = log.New(os.Stdout, "INFO: ", log.Ldate|log.Ltime|log.Lshortfile)
-	DebugLogger = log.New(os.Stdout, "DEBUG: ", log.Ldate|log.Ltime|log.Lshortfile)
-	ErrorLogger = log.New(os.Stderr, "ERROR: ", log.Ldate|log.Ltime|log.Lshortfile)
-	FatalLogger = log.New(os.Stderr, "FATAL: ", log.Ldate|log.Ltime|log.Lshortfile)
+	level = new(slog.LevelVar)
+	base  = slog.New(newContextHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     level,
+	})))
 )
+
+func init() {
+	slog.SetDefault(base)
+}
+
+// SetLevel changes the minimum level at runtime; it accepts the names
+// understood by slog.Level, e.g. "debug", "INFO" or "warn+2".
+func SetLevel(name string) error {
+	var newLevel slog.Level
+	if err := newLevel.UnmarshalText([]byte(name)); err != nil {
+		return err
+	}
+
+	level.Set(newLevel)
+
+	return nil
+}
+
+func Level() slog.Level {
+	return level.Level()
+}
+
+func Debug(ctx context.Context, msg string, args ...any) {
+	log(ctx, slog.LevelDebug, msg, args...)
+}
+
+func Info(ctx context.Context, msg string, args ...any) {
+	log(ctx, slog.LevelInfo, msg, args...)
+}
+
+func Warn(ctx context.Context, msg string, args ...any) {
+	log(ctx, slog.LevelWarn, msg, args...)
+}
+
+func Error(ctx context.Context, msg string, args ...any) {
+	log(ctx, slog.LevelError, msg, args...)
+}
+
+// Fatal logs at error level and exits the process
+func Fatal(ctx context.Context, msg string, args ...any) {
+	log(ctx, slog.LevelError, msg, args...)
+	os.Exit(1)
+}